@@ -38,10 +38,53 @@ import (
 	orderhttp "r2-challenge/internal/order/adapters/http"
 	notification "r2-challenge/internal/order/adapters/notification"
 	payment "r2-challenge/internal/order/adapters/payment"
+	"r2-challenge/internal/order/adapters/shipping"
+	"r2-challenge/internal/order/adapters/tax"
 	ordercmd "r2-challenge/internal/order/services/command"
 	orderqry "r2-challenge/internal/order/services/query"
 	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmthttp "r2-challenge/internal/payment/adapters/http"
 	pmtcmd "r2-challenge/internal/payment/services/command"
+	pmtqry "r2-challenge/internal/payment/services/query"
+
+	cartdb "r2-challenge/internal/cart/adapters/db"
+	carthttp "r2-challenge/internal/cart/adapters/http"
+	cartcmd "r2-challenge/internal/cart/services/command"
+	cartqry "r2-challenge/internal/cart/services/query"
+
+	rsvdb "r2-challenge/internal/reservation/adapters/db"
+	rsvhttp "r2-challenge/internal/reservation/adapters/http"
+	rsvcmd "r2-challenge/internal/reservation/services/command"
+	rsvqry "r2-challenge/internal/reservation/services/query"
+
+	outboxdb "r2-challenge/internal/outbox/adapters/db"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+
+	webhookdb "r2-challenge/internal/webhook/adapters/db"
+	webhookhttp "r2-challenge/internal/webhook/adapters/http"
+	webhookcmd "r2-challenge/internal/webhook/services/command"
+	webhookqry "r2-challenge/internal/webhook/services/query"
+
+	invoicedb "r2-challenge/internal/invoice/adapters/db"
+	invoicehttp "r2-challenge/internal/invoice/adapters/http"
+	invoicepdf "r2-challenge/internal/invoice/adapters/pdf"
+	invoicecmd "r2-challenge/internal/invoice/services/command"
+	invoiceqry "r2-challenge/internal/invoice/services/query"
+
+	ledgerdb "r2-challenge/internal/ledger/adapters/db"
+	ledgerhttp "r2-challenge/internal/ledger/adapters/http"
+	ledgercmd "r2-challenge/internal/ledger/services/command"
+	ledgerqry "r2-challenge/internal/ledger/services/query"
+
+	promodb "r2-challenge/internal/promotion/adapters/db"
+	promohttp "r2-challenge/internal/promotion/adapters/http"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	promoqry "r2-challenge/internal/promotion/services/query"
+
+	returndb "r2-challenge/internal/returns/adapters/db"
+	returnhttp "r2-challenge/internal/returns/adapters/http"
+	returncmd "r2-challenge/internal/returns/services/command"
+	returnqry "r2-challenge/internal/returns/services/query"
 
 	"github.com/labstack/echo/v4"
 )
@@ -58,6 +101,7 @@ func main() {
 			validator.Setup,
 			db.Setup,
 			cache.SetupFromEnv,
+			db.NewTransactor,
 		),
 
 		fx.Provide(
@@ -83,22 +127,160 @@ func main() {
 			userhttp.NewGetUserHandler,
 			userhttp.NewListUsersHandler,
 			userhttp.NewUpdateProfileHandler,
+			userdb.NewAddressRepository,
+			usercmd.NewCreateAddressService,
+			usercmd.NewUpdateAddressService,
+			usercmd.NewDeleteAddressService,
+			userqry.NewGetAddressService,
+			userqry.NewListAddressesService,
+			userhttp.NewCreateAddressHandler,
+			userhttp.NewUpdateAddressHandler,
+			userhttp.NewDeleteAddressHandler,
+			userhttp.NewGetAddressHandler,
+			userhttp.NewListAddressesHandler,
 
 			orderdb.NewDBRepository,
-			payment.NewNoopProcessor,
-			notification.NewNoopSender,
+			orderdb.NewShipmentRepository,
+			payment.NewProcessor,
+			tax.NewCalculator,
+			shipping.NewCalculator,
+			notification.NewSender,
 			pmtdb.NewDBRepository,
 			pmtcmd.NewService,
+			pmtcmd.NewRefundService,
+			pmtcmd.NewAuthorizationService,
+			pmtcmd.NewProviderEventService,
+			pmtcmd.NewReconcileService,
+			pmtqry.NewGetPaymentService,
+			pmtqry.NewListByOrderService,
+			pmtqry.NewListByUserService,
+			pmtqry.NewOrderSummaryService,
+			pmtqry.NewGetReconciliationService,
+			pmtqry.NewListReconciliationsService,
+			pmthttp.NewGetPaymentHandler,
+			pmthttp.NewListPaymentsHandler,
+			pmthttp.NewProviderWebhookHandler,
+			pmthttp.NewReconcileHandler,
+			pmthttp.NewListReconciliationsHandler,
+			pmthttp.NewGetReconciliationHandler,
 			ordercmd.NewPlaceOrderService,
 			ordercmd.NewUpdateStatusService,
+			ordercmd.NewCancelOrderService,
+			ordercmd.NewSendConfirmationService,
+			ordercmd.NewRefundOrderService,
+			ordercmd.NewSendRefundService,
+			ordercmd.NewCapturePaymentService,
+			ordercmd.NewSendVoidService,
+			ordercmd.NewApplyPaymentEventService,
+			ordercmd.NewCreateShipmentService,
+			ordercmd.NewDeliverShipmentService,
+			ordercmd.NewExpireUnpaidService,
 			orderqry.NewService,
+			orderqry.NewListShipmentsService,
+			orderqry.NewSearchOrdersService,
+			orderqry.NewTimelineService,
 			orderhttp.NewPlaceOrderHandler,
 			orderhttp.NewGetOrderHandler,
 			orderhttp.NewListUserOrdersHandler,
 			orderhttp.NewUpdateStatusHandler,
+			orderhttp.NewCancelOrderHandler,
+			orderhttp.NewRefundOrderHandler,
+			orderhttp.NewListOrderPaymentsHandler,
+			orderhttp.NewCreateShipmentHandler,
+			orderhttp.NewDeliverShipmentHandler,
+			orderhttp.NewListShipmentsHandler,
+			orderhttp.NewSearchOrdersHandler,
+			orderhttp.NewGetTimelineHandler,
+
+			cartdb.NewRepository,
+			cartqry.NewGetCartService,
+			cartcmd.NewAddItemService,
+			cartcmd.NewUpdateItemService,
+			cartcmd.NewRemoveItemService,
+			cartcmd.NewCheckoutService,
+			carthttp.NewGetCartHandler,
+			carthttp.NewAddItemHandler,
+			carthttp.NewUpdateItemHandler,
+			carthttp.NewRemoveItemHandler,
+			carthttp.NewCheckoutHandler,
+
+			rsvdb.NewDBRepository,
+			rsvcmd.NewReserveService,
+			rsvcmd.NewReleaseService,
+			rsvcmd.NewReleaseExpiredService,
+			rsvqry.NewListByUserService,
+			rsvhttp.NewCreateReservationHandler,
+			rsvhttp.NewReleaseReservationHandler,
+			rsvhttp.NewListReservationsHandler,
+
+			outboxdb.NewDBRepository,
+			outboxcmd.NewRegistry,
+			outboxcmd.NewPublishService,
+			outboxcmd.NewDispatchService,
+
+			webhookdb.NewDBRepository,
+			webhookcmd.NewCreateSubscriptionService,
+			webhookcmd.NewUpdateSubscriptionService,
+			webhookcmd.NewDeleteSubscriptionService,
+			webhookcmd.NewEnqueueDeliveriesService,
+			webhookcmd.NewDeliverService,
+			webhookcmd.NewRedeliverService,
+			webhookqry.NewGetSubscriptionService,
+			webhookqry.NewListSubscriptionsService,
+			webhookqry.NewListDeliveriesService,
+			webhookhttp.NewCreateSubscriptionHandler,
+			webhookhttp.NewUpdateSubscriptionHandler,
+			webhookhttp.NewDeleteSubscriptionHandler,
+			webhookhttp.NewGetSubscriptionHandler,
+			webhookhttp.NewListSubscriptionsHandler,
+			webhookhttp.NewListDeliveriesHandler,
+			webhookhttp.NewRedeliverHandler,
+
+			ledgerdb.NewDBRepository,
+			ledgercmd.NewRecordMovementService,
+			ledgerqry.NewBalancesService,
+			ledgerqry.NewListEntriesService,
+			ledgerqry.NewCheckService,
+			ledgerhttp.NewListAccountsHandler,
+			ledgerhttp.NewGetAccountHandler,
+			ledgerhttp.NewListEntriesHandler,
+			ledgerhttp.NewCheckHandler,
+
+			promodb.NewDBRepository,
+			promocmd.NewCreateCouponService,
+			promocmd.NewUpdateCouponService,
+			promocmd.NewRedeemService,
+			promoqry.NewGetCouponService,
+			promoqry.NewListCouponsService,
+			promohttp.NewCreateCouponHandler,
+			promohttp.NewUpdateCouponHandler,
+			promohttp.NewGetCouponHandler,
+			promohttp.NewListCouponsHandler,
+
+			returndb.NewDBRepository,
+			returncmd.NewRequestReturnService,
+			returncmd.NewApproveReturnService,
+			returncmd.NewRejectReturnService,
+			returncmd.NewReceiveReturnService,
+			returnqry.NewGetReturnService,
+			returnqry.NewListReturnsService,
+			returnhttp.NewRequestReturnHandler,
+			returnhttp.NewApproveReturnHandler,
+			returnhttp.NewRejectReturnHandler,
+			returnhttp.NewReceiveReturnHandler,
+			returnhttp.NewGetReturnHandler,
+			returnhttp.NewListReturnsHandler,
+
+			invoicedb.NewDBRepository,
+			invoicepdf.NewRenderer,
+			invoicecmd.NewIssueInvoiceService,
+			invoiceqry.NewRenderInvoiceService,
+			invoicehttp.NewGetInvoiceHandler,
 		),
 
+		fx.Invoke(subscribeOutboxHandlers),
 		fx.Invoke(runHTTPServer),
+		fx.Invoke(runWorkers),
 	)
 
 	app.Run()
@@ -120,10 +302,59 @@ func runHTTPServer(
 	getUser userhttp.GetUserHandler,
 	listUsers userhttp.ListUsersHandler,
 	updateProfile userhttp.UpdateProfileHandler,
+	createAddress userhttp.CreateAddressHandler,
+	updateAddress userhttp.UpdateAddressHandler,
+	deleteAddress userhttp.DeleteAddressHandler,
+	getAddress userhttp.GetAddressHandler,
+	listAddresses userhttp.ListAddressesHandler,
 	place orderhttp.PlaceOrderHandler,
 	getOrder orderhttp.GetOrderHandler,
 	listOrders orderhttp.ListUserOrdersHandler,
 	updateOrderStatus orderhttp.UpdateStatusHandler,
+	cancelOrder orderhttp.CancelOrderHandler,
+	refundOrder orderhttp.RefundOrderHandler,
+	listOrderPayments orderhttp.ListOrderPaymentsHandler,
+	createShipment orderhttp.CreateShipmentHandler,
+	deliverShipment orderhttp.DeliverShipmentHandler,
+	listShipments orderhttp.ListShipmentsHandler,
+	searchOrders orderhttp.SearchOrdersHandler,
+	getTimeline orderhttp.GetTimelineHandler,
+	getPayment pmthttp.GetPaymentHandler,
+	listPayments pmthttp.ListPaymentsHandler,
+	providerWebhook pmthttp.ProviderWebhookHandler,
+	reconcile pmthttp.ReconcileHandler,
+	listReconciliations pmthttp.ListReconciliationsHandler,
+	getReconciliation pmthttp.GetReconciliationHandler,
+	getCart carthttp.GetCartHandler,
+	addCartItem carthttp.AddItemHandler,
+	updateCartItem carthttp.UpdateItemHandler,
+	removeCartItem carthttp.RemoveItemHandler,
+	checkout carthttp.CheckoutHandler,
+	createReservation rsvhttp.CreateReservationHandler,
+	releaseReservation rsvhttp.ReleaseReservationHandler,
+	listReservations rsvhttp.ListReservationsHandler,
+	createWebhook webhookhttp.CreateSubscriptionHandler,
+	updateWebhook webhookhttp.UpdateSubscriptionHandler,
+	deleteWebhook webhookhttp.DeleteSubscriptionHandler,
+	getWebhook webhookhttp.GetSubscriptionHandler,
+	listWebhooks webhookhttp.ListSubscriptionsHandler,
+	listWebhookDeliveries webhookhttp.ListDeliveriesHandler,
+	redeliverWebhook webhookhttp.RedeliverHandler,
+	listLedgerAccounts ledgerhttp.ListAccountsHandler,
+	getLedgerAccount ledgerhttp.GetAccountHandler,
+	listLedgerEntries ledgerhttp.ListEntriesHandler,
+	checkLedger ledgerhttp.CheckHandler,
+	createCoupon promohttp.CreateCouponHandler,
+	updateCoupon promohttp.UpdateCouponHandler,
+	getCoupon promohttp.GetCouponHandler,
+	listCoupons promohttp.ListCouponsHandler,
+	requestReturn returnhttp.RequestReturnHandler,
+	approveReturn returnhttp.ApproveReturnHandler,
+	rejectReturn returnhttp.RejectReturnHandler,
+	receiveReturn returnhttp.ReceiveReturnHandler,
+	getReturn returnhttp.GetReturnHandler,
+	listReturns returnhttp.ListReturnsHandler,
+	getInvoice invoicehttp.GetInvoiceHandler,
 ) error {
 	e := httpx.NewServer(tracer)
 
@@ -175,13 +406,77 @@ func runHTTPServer(
 	v1.GET("/users/:id", auth.RequireSelfOrRoles("id", "admin")(getUser.Handle))
 	v1.GET("/users", auth.RequireRoles("admin")(listUsers.Handle))
 	v1.PUT("/users/me", updateProfile.Handle)
+	v1.GET("/users/me/addresses", listAddresses.Handle)
+	v1.POST("/users/me/addresses", createAddress.Handle)
+	v1.GET("/users/me/addresses/:id", getAddress.Handle)
+	v1.PUT("/users/me/addresses/:id", updateAddress.Handle)
+	v1.DELETE("/users/me/addresses/:id", deleteAddress.Handle)
 
 	// Orders
 	// Idempotency only for order placement (short TTL)
 	v1.POST("/orders", place.Handle, httpx.IdempotencyMiddleware(cch, 2*time.Minute))
+	v1.GET("/orders", auth.RequireRoles("admin")(searchOrders.Handle))
 	v1.GET("/orders/:id", auth.RequireRoles("admin")(getOrder.Handle))
 	v1.GET("/users/:id/orders", listOrders.Handle)
 	v1.PUT("/orders/:id/status", auth.RequireRoles("admin")(updateOrderStatus.Handle))
+	v1.POST("/orders/:id/cancel", cancelOrder.Handle)
+	v1.POST("/orders/:id/refunds", auth.RequireRoles("admin")(refundOrder.Handle))
+	v1.GET("/orders/:id/payments", listOrderPayments.Handle)
+	v1.GET("/orders/:id/timeline", getTimeline.Handle)
+	v1.GET("/orders/:id/invoice", getInvoice.Handle)
+	v1.POST("/orders/:id/shipments", auth.RequireRoles("admin")(createShipment.Handle))
+	v1.GET("/orders/:id/shipments", listShipments.Handle)
+	v1.POST("/orders/:id/shipments/:shipment_id/deliver", auth.RequireRoles("admin")(deliverShipment.Handle))
+
+	// Payments (admin-only)
+	v1.GET("/payments", auth.RequireRoles("admin")(listPayments.Handle))
+	v1.GET("/payments/:id", auth.RequireRoles("admin")(getPayment.Handle))
+	v1.POST("/payments/reconciliations", auth.RequireRoles("admin")(reconcile.Handle))
+	v1.GET("/payments/reconciliations", auth.RequireRoles("admin")(listReconciliations.Handle))
+	v1.GET("/payments/reconciliations/:id", auth.RequireRoles("admin")(getReconciliation.Handle))
+	// signed by the provider instead of a JWT
+	v1.POST("/payments/webhooks/:provider", providerWebhook.Handle)
+
+	// Cart
+	v1.GET("/cart", getCart.Handle)
+	v1.POST("/cart/items", addCartItem.Handle)
+	v1.PUT("/cart/items/:productId", updateCartItem.Handle)
+	v1.DELETE("/cart/items/:productId", removeCartItem.Handle)
+	v1.POST("/cart/checkout", checkout.Handle, httpx.IdempotencyMiddleware(cch, 2*time.Minute))
+
+	// Reservations
+	v1.POST("/reservations", createReservation.Handle)
+	v1.GET("/reservations", listReservations.Handle)
+	v1.DELETE("/reservations/:id", releaseReservation.Handle)
+
+	// Webhooks (admin-only)
+	v1.POST("/webhooks", auth.RequireRoles("admin")(createWebhook.Handle))
+	v1.GET("/webhooks", auth.RequireRoles("admin")(listWebhooks.Handle))
+	v1.GET("/webhooks/:id", auth.RequireRoles("admin")(getWebhook.Handle))
+	v1.PUT("/webhooks/:id", auth.RequireRoles("admin")(updateWebhook.Handle))
+	v1.DELETE("/webhooks/:id", auth.RequireRoles("admin")(deleteWebhook.Handle))
+	v1.GET("/webhooks/:id/deliveries", auth.RequireRoles("admin")(listWebhookDeliveries.Handle))
+	v1.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", auth.RequireRoles("admin")(redeliverWebhook.Handle))
+
+	// Ledger (admin-only)
+	v1.GET("/ledger/accounts", auth.RequireRoles("admin")(listLedgerAccounts.Handle))
+	v1.GET("/ledger/accounts/:code", auth.RequireRoles("admin")(getLedgerAccount.Handle))
+	v1.GET("/ledger/entries", auth.RequireRoles("admin")(listLedgerEntries.Handle))
+	v1.GET("/ledger/check", auth.RequireRoles("admin")(checkLedger.Handle))
+
+	// Coupons (admin-only; customers redeem them with coupon_code on checkout)
+	v1.POST("/coupons", auth.RequireRoles("admin")(createCoupon.Handle))
+	v1.GET("/coupons", auth.RequireRoles("admin")(listCoupons.Handle))
+	v1.GET("/coupons/:id", auth.RequireRoles("admin")(getCoupon.Handle))
+	v1.PUT("/coupons/:id", auth.RequireRoles("admin")(updateCoupon.Handle))
+
+	// Returns (customers request them on their orders; admins review them)
+	v1.POST("/orders/:id/returns", requestReturn.Handle)
+	v1.GET("/returns", listReturns.Handle)
+	v1.GET("/returns/:id", getReturn.Handle)
+	v1.POST("/returns/:id/approve", auth.RequireRoles("admin")(approveReturn.Handle))
+	v1.POST("/returns/:id/reject", auth.RequireRoles("admin")(rejectReturn.Handle))
+	v1.POST("/returns/:id/receive", auth.RequireRoles("admin")(receiveReturn.Handle))
 
 	readHeaderTimeout, _ := time.ParseDuration(envs.ReadHeaderTimeout)
 	httpTimeout, _ := time.ParseDuration(envs.HTTPTimeout)
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	ledgercmd "r2-challenge/internal/ledger/services/command"
+	orderdomain "r2-challenge/internal/order/domain"
+	ordercmd "r2-challenge/internal/order/services/command"
+	outboxdomain "r2-challenge/internal/outbox/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	webhookdomain "r2-challenge/internal/webhook/domain"
+	webhookcmd "r2-challenge/internal/webhook/services/command"
+)
+
+// subscribeOutboxHandlers wires the outbox topics to the services that
+// deliver their side effects.
+func subscribeOutboxHandlers(reg *outboxcmd.Registry, confirmation ordercmd.SendConfirmationService, refunds ordercmd.SendRefundService, captures ordercmd.CapturePaymentService, voids ordercmd.SendVoidService, webhooks webhookcmd.EnqueueDeliveriesService, ledger ledgercmd.RecordMovementService) {
+	confirm := func(ctx context.Context, e outboxdomain.Event) error {
+		var p pmtdomain.Payment
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return err
+		}
+		// two-phase payments were confirmed when they were authorized
+		if e.Topic == pmtdomain.TopicPaymentCaptured && p.AuthorizationID != "" {
+			return nil
+		}
+		return confirmation.Send(ctx, p.OrderID)
+	}
+	reg.Subscribe(pmtdomain.TopicPaymentCaptured, "order-confirmation-email", confirm)
+	reg.Subscribe(pmtdomain.TopicPaymentAuthorized, "order-confirmation-email", confirm)
+
+	// refunds are paid back only once their records have committed
+	reg.Subscribe(pmtdomain.TopicPaymentRefunded, "payment-refunds", func(ctx context.Context, e outboxdomain.Event) error {
+		var r pmtdomain.Refund
+		if err := json.Unmarshal(e.Payload, &r); err != nil {
+			return err
+		}
+		return refunds.Send(ctx, r)
+	})
+
+	// authorizations are released only once their void has committed
+	reg.Subscribe(pmtdomain.TopicPaymentVoided, "payment-voids", func(ctx context.Context, e outboxdomain.Event) error {
+		var p pmtdomain.Payment
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return err
+		}
+		return voids.Send(ctx, p)
+	})
+
+	// authorizations are captured only once the shipment that collects them
+	// has committed
+	reg.Subscribe(pmtdomain.TopicPaymentCaptureRequested, "payment-captures", func(ctx context.Context, e outboxdomain.Event) error {
+		var p pmtdomain.Payment
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return err
+		}
+		return captures.Capture(ctx, p)
+	})
+
+	for _, topic := range []string{
+		orderdomain.TopicOrderPlaced,
+		orderdomain.TopicOrderStatusChanged,
+		orderdomain.TopicOrderExpired,
+		pmtdomain.TopicPaymentCaptured,
+		pmtdomain.TopicPaymentFailed,
+		pmtdomain.TopicPaymentVoided,
+		pmtdomain.TopicPaymentRefunded,
+	} {
+		reg.Subscribe(topic, "ledger", ledger.Record)
+	}
+
+	for _, event := range webhookdomain.Events {
+		reg.Subscribe(event, "webhooks", webhooks.Enqueue)
+	}
+}
@@ -18,7 +18,9 @@ var publicRoutes = map[routeKey]struct{}{
 	{Method: POST, Path: "/v1/auth/login"}:    {},
 	{Method: GET, Path: "/v1/products"}:       {},
 	{Method: GET, Path: "/v1/products/:id"}:   {},
-	{Method: GET, Path: "/swagger"}:           {},
-	{Method: GET, Path: "/swagger.yaml"}:      {},
+	// provider events authenticate with their signature
+	{Method: POST, Path: "/v1/payments/webhooks/:provider"}: {},
+	{Method: GET, Path: "/swagger"}:                         {},
+	{Method: GET, Path: "/swagger.yaml"}:                    {},
 	// Allow preflight and swagger assets without token handled in middleware
 }
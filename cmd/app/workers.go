@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"r2-challenge/cmd/envs"
+	ordercmd "r2-challenge/internal/order/services/command"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	rsvcmd "r2-challenge/internal/reservation/services/command"
+	webhookcmd "r2-challenge/internal/webhook/services/command"
+	"r2-challenge/pkg/worker"
+)
+
+// runWorkers registers the background jobs on the fx lifecycle.
+func runWorkers(
+	lc fx.Lifecycle,
+	envs envs.Envs,
+	log *zap.Logger,
+	releaseExpired rsvcmd.ReleaseExpiredService,
+	dispatcher outboxcmd.DispatchService,
+	webhooks webhookcmd.DeliverService,
+	reconciler pmtcmd.ReconcileService,
+	expireUnpaid ordercmd.ExpireUnpaidService,
+) {
+	sweepInterval := parseDurationOr(envs.ReservationSweepInterval, time.Minute)
+	lc.Append(worker.Periodic("reservation-sweeper", sweepInterval, log, func(ctx context.Context) error {
+		released, err := releaseExpired.ReleaseExpired(ctx)
+		if released > 0 {
+			log.Info("released expired reservations", zap.Int64("count", released))
+		}
+		return err
+	}))
+
+	expiryInterval := parseDurationOr(envs.OrderExpiryInterval, 5*time.Minute)
+	lc.Append(worker.Periodic("order-expirer", expiryInterval, log, func(ctx context.Context) error {
+		expired, err := expireUnpaid.ExpireUnpaid(ctx)
+		if expired > 0 {
+			log.Info("cancelled unpaid orders", zap.Int("count", expired))
+		}
+		return err
+	}))
+
+	pollInterval := parseDurationOr(envs.OutboxPollInterval, time.Second)
+	lc.Append(worker.Periodic("outbox-dispatcher", pollInterval, log, func(ctx context.Context) error {
+		_, err := dispatcher.DispatchDue(ctx)
+		return err
+	}))
+
+	webhookInterval := parseDurationOr(envs.WebhookPollInterval, 2*time.Second)
+	lc.Append(worker.Periodic("webhook-deliverer", webhookInterval, log, func(ctx context.Context) error {
+		_, err := webhooks.DeliverDue(ctx)
+		return err
+	}))
+
+	// each run reconciles the previous interval, shifted back by the delay the
+	// provider needs to settle
+	reconcileInterval := parseDurationOr(envs.ReconciliationInterval, 24*time.Hour)
+	reconcileDelay := parseDurationOr(envs.ReconciliationDelay, time.Hour)
+	lc.Append(worker.Periodic("payment-reconciler", reconcileInterval, log, func(ctx context.Context) error {
+		to := time.Now().UTC().Add(-reconcileDelay)
+		rec, err := reconciler.Reconcile(ctx, to.Add(-reconcileInterval), to)
+		if errors.Is(err, pmtdomain.ErrNoSettlementReport) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(rec.Mismatches) > 0 {
+			log.Warn("payment reconciliation found mismatches", zap.String("reconciliation", rec.ID), zap.Int("mismatches", len(rec.Mismatches)))
+		}
+		return nil
+	}))
+}
+
+func parseDurationOr(s string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
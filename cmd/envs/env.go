@@ -32,6 +32,69 @@ type Envs struct {
 	JWTIssuer string `cfg:"JWT_ISSUER" cfgDefault:"r2-challenge"`
 	JWTExpire string `cfg:"JWT_EXPIRE" cfgDefault:"1h"`
 
+	// Inventory reservations
+	ReservationTTL           string `cfg:"RESERVATION_TTL" cfgDefault:"15m"`
+	ReservationSweepInterval string `cfg:"RESERVATION_SWEEP_INTERVAL" cfgDefault:"1m"`
+
+	// Unpaid orders: orders still waiting for a payment ORDER_PAYMENT_TTL
+	// after placement are cancelled by a job running every ORDER_EXPIRY_INTERVAL
+	OrderPaymentTTL     string `cfg:"ORDER_PAYMENT_TTL" cfgDefault:"24h"`
+	OrderExpiryInterval string `cfg:"ORDER_EXPIRY_INTERVAL" cfgDefault:"5m"`
+
+	// Payments: "immediate" charges at checkout, "on_shipment" authorizes at
+	// checkout and captures when the order ships
+	PaymentCaptureMode string `cfg:"PAYMENT_CAPTURE_MODE" cfgDefault:"immediate"`
+	// "noop" (default) or "gateway" for the REST payment gateway
+	PaymentProvider            string `cfg:"PAYMENT_PROVIDER" cfgDefault:"noop"`
+	PaymentGatewayURL          string `cfg:"PAYMENT_GATEWAY_URL"`
+	PaymentGatewayAPIKey       string `cfg:"PAYMENT_GATEWAY_API_KEY"`
+	PaymentGatewayTimeout      string `cfg:"PAYMENT_GATEWAY_TIMEOUT" cfgDefault:"10s"`
+	PaymentGatewayMaxRetries   int    `cfg:"PAYMENT_GATEWAY_MAX_RETRIES" cfgDefault:"3"`
+	PaymentGatewayRetryBackoff string `cfg:"PAYMENT_GATEWAY_RETRY_BACKOFF" cfgDefault:"200ms"`
+	// Inbound provider webhooks: "<provider>=<secret>" pairs separated by commas
+	PaymentWebhookSecrets   string `cfg:"PAYMENT_WEBHOOK_SECRETS"`
+	PaymentWebhookTolerance string `cfg:"PAYMENT_WEBHOOK_TOLERANCE" cfgDefault:"5m"`
+
+	// Tax rates: "<country>[-<region>][:<category>]=<percent>" entries
+	// separated by commas; orders are untaxed when empty
+	TaxRates string `cfg:"TAX_RATES"`
+
+	// Shipping: the "flat" and "weight" methods; orders whose items reach
+	// SHIPPING_FREE_OVER_CENTS after discounts ship for free (0 disables it)
+	ShippingDefaultMethod    string `cfg:"SHIPPING_DEFAULT_METHOD" cfgDefault:"flat"`
+	ShippingFlatCents        int    `cfg:"SHIPPING_FLAT_CENTS" cfgDefault:"500"`
+	ShippingWeightBaseCents  int    `cfg:"SHIPPING_WEIGHT_BASE_CENTS" cfgDefault:"300"`
+	ShippingWeightCentsPerKg int    `cfg:"SHIPPING_WEIGHT_CENTS_PER_KG" cfgDefault:"200"`
+	ShippingFreeOverCents    int    `cfg:"SHIPPING_FREE_OVER_CENTS" cfgDefault:"0"`
+
+	// Invoices: the seller printed on them; the address lines are separated by ";"
+	InvoiceSellerName    string `cfg:"INVOICE_SELLER_NAME" cfgDefault:"R2 Challenge"`
+	InvoiceSellerAddress string `cfg:"INVOICE_SELLER_ADDRESS"`
+	InvoiceSellerTaxID   string `cfg:"INVOICE_SELLER_TAX_ID"`
+
+	// Payment reconciliation job
+	ReconciliationInterval string `cfg:"RECONCILIATION_INTERVAL" cfgDefault:"24h"`
+	ReconciliationDelay    string `cfg:"RECONCILIATION_DELAY" cfgDefault:"1h"`
+
+	// Outbox dispatcher
+	OutboxPollInterval string `cfg:"OUTBOX_POLL_INTERVAL" cfgDefault:"1s"`
+	OutboxBatchSize    int    `cfg:"OUTBOX_BATCH_SIZE" cfgDefault:"50"`
+	OutboxMaxAttempts  int    `cfg:"OUTBOX_MAX_ATTEMPTS" cfgDefault:"8"`
+	OutboxRetryBackoff string `cfg:"OUTBOX_RETRY_BACKOFF" cfgDefault:"2s"`
+
+	// Outbound webhooks
+	WebhookPollInterval string `cfg:"WEBHOOK_POLL_INTERVAL" cfgDefault:"2s"`
+	WebhookTimeout      string `cfg:"WEBHOOK_TIMEOUT" cfgDefault:"10s"`
+	WebhookMaxAttempts  int    `cfg:"WEBHOOK_MAX_ATTEMPTS" cfgDefault:"10"`
+	WebhookRetryBackoff string `cfg:"WEBHOOK_RETRY_BACKOFF" cfgDefault:"30s"`
+
+	// SMTP (optional); confirmation emails are skipped when SMTP_HOST is empty
+	SMTPHost     string `cfg:"SMTP_HOST"`
+	SMTPPort     string `cfg:"SMTP_PORT" cfgDefault:"587"`
+	SMTPUsername string `cfg:"SMTP_USERNAME"`
+	SMTPPassword string `cfg:"SMTP_PASSWORD"`
+	SMTPFrom     string `cfg:"SMTP_FROM" cfgDefault:"no-reply@r2-challenge.local"`
+
 	// Redis (optional)
 	RedisAddr     string `cfg:"REDIS_ADDR" cfgDefault:"localhost:6379"`
 	RedisPassword string `cfg:"REDIS_PASSWORD"`
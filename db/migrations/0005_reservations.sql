@@ -0,0 +1,14 @@
+-- Inventory reservations (time-boxed stock holds)
+CREATE TABLE IF NOT EXISTS reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    status TEXT NOT NULL DEFAULT 'active',
+    order_id UUID REFERENCES orders(id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_reservations_active_product ON reservations(product_id, expires_at) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS idx_reservations_user_id ON reservations(user_id);
@@ -0,0 +1,3 @@
+-- Two-phase payments: authorization id of authorize/capture payments
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS authorization_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_payments_order_status ON payments(order_id, status);
@@ -0,0 +1,11 @@
+-- Inbound payment provider events, kept to apply each event only once
+CREATE TABLE IF NOT EXISTS payment_provider_events (
+    provider TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    reference TEXT NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, event_id)
+);
+CREATE INDEX IF NOT EXISTS idx_payments_receipt_id ON payments(receipt_id);
+CREATE INDEX IF NOT EXISTS idx_payments_authorization_id ON payments(authorization_id);
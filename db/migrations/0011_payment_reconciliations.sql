@@ -0,0 +1,11 @@
+-- Results of matching provider settlement reports against payments
+CREATE TABLE IF NOT EXISTS payment_reconciliations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source TEXT NOT NULL,
+    period_from TIMESTAMPTZ NOT NULL,
+    period_to TIMESTAMPTZ NOT NULL,
+    checked INT NOT NULL DEFAULT 0,
+    mismatches JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_payment_reconciliations_created_at ON payment_reconciliations(created_at);
@@ -0,0 +1,64 @@
+-- Append-only double-entry ledger
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    code TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO ledger_accounts (code, name, type) VALUES
+    ('receivable', 'Customer receivables', 'asset'),
+    ('processor_clearing', 'Payment processor clearing', 'asset'),
+    ('sales', 'Sales', 'revenue'),
+    ('refunds', 'Sales refunds', 'contra_revenue')
+ON CONFLICT (code) DO NOTHING;
+
+-- order_id has no foreign key: ledger rows outlive the orders they describe
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    idempotency_key TEXT NOT NULL UNIQUE,
+    order_id UUID,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_order_id ON ledger_entries(order_id);
+
+CREATE TABLE IF NOT EXISTS ledger_postings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_id UUID NOT NULL REFERENCES ledger_entries(id),
+    account_code TEXT NOT NULL REFERENCES ledger_accounts(code),
+    amount_cents BIGINT NOT NULL CHECK (amount_cents <> 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_ledger_postings_entry_id ON ledger_postings(entry_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_postings_account_code ON ledger_postings(account_code);
+
+-- entries and postings are never changed; corrections are new entries
+CREATE OR REPLACE FUNCTION ledger_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger is append-only: % on %', TG_OP, TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_entries_append_only ON ledger_entries;
+CREATE TRIGGER ledger_entries_append_only BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+DROP TRIGGER IF EXISTS ledger_postings_append_only ON ledger_postings;
+CREATE TRIGGER ledger_postings_append_only BEFORE UPDATE OR DELETE ON ledger_postings
+    FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+-- checked at commit, once every posting of the entry is written
+CREATE OR REPLACE FUNCTION ledger_entry_balanced() RETURNS trigger AS $$
+BEGIN
+    IF (SELECT SUM(amount_cents) FROM ledger_postings WHERE entry_id = NEW.entry_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger entry % does not balance', NEW.entry_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS ledger_postings_balanced ON ledger_postings;
+CREATE CONSTRAINT TRIGGER ledger_postings_balanced AFTER INSERT ON ledger_postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_entry_balanced();
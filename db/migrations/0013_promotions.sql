@@ -0,0 +1,39 @@
+-- Coupons, their redemptions and discounts on orders
+CREATE TABLE IF NOT EXISTS coupons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code TEXT NOT NULL UNIQUE,
+    type TEXT NOT NULL,
+    percent_off BIGINT NOT NULL DEFAULT 0,
+    amount_off_cents BIGINT NOT NULL DEFAULT 0,
+    free_product_id TEXT NOT NULL DEFAULT '',
+    free_quantity BIGINT NOT NULL DEFAULT 0,
+    min_spend_cents BIGINT NOT NULL DEFAULT 0,
+    categories JSONB NOT NULL DEFAULT '[]',
+    max_uses BIGINT NOT NULL DEFAULT 0,
+    max_uses_per_user BIGINT NOT NULL DEFAULT 0,
+    uses BIGINT NOT NULL DEFAULT 0,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS coupon_redemptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    coupon_id UUID NOT NULL REFERENCES coupons(id),
+    code TEXT NOT NULL,
+    order_id UUID NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    discount_cents BIGINT NOT NULL CHECK (discount_cents >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_coupon_user ON coupon_redemptions(coupon_id, user_id);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS subtotal_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS discount_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS coupon_code TEXT NOT NULL DEFAULT '';
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS discount_cents BIGINT NOT NULL DEFAULT 0;
+
+-- orders placed before discounts existed paid their subtotal
+UPDATE orders SET subtotal_cents = total_cents WHERE subtotal_cents = 0 AND discount_cents = 0 AND total_cents <> 0;
@@ -0,0 +1,17 @@
+-- Tax on orders and their items, and the ledger account that owes it
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax_country TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax_region TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS subtotal_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS tax_rate_bps BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS tax_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS total_cents BIGINT NOT NULL DEFAULT 0;
+
+-- items placed before taxes existed were untaxed
+UPDATE order_items SET subtotal_cents = price_cents * quantity, total_cents = price_cents * quantity - discount_cents
+WHERE subtotal_cents = 0 AND total_cents = 0;
+
+INSERT INTO ledger_accounts (code, name, type) VALUES
+    ('tax_payable', 'Sales tax payable', 'liability')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,9 @@
+-- Admin order search: keyset pagination walks (sort column, id)
+CREATE INDEX IF NOT EXISTS idx_orders_created_at_id ON orders(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_orders_total_cents_id ON orders(total_cents, id);
+CREATE INDEX IF NOT EXISTS idx_orders_status_created_at_id ON orders(status, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_created_at_id ON orders(user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_order_items_product_id ON order_items(product_id);
+
+-- covered by idx_orders_created_at_id
+DROP INDEX IF EXISTS idx_orders_created_at;
@@ -0,0 +1,17 @@
+-- Status history of orders, written with each status change
+CREATE TABLE IF NOT EXISTS order_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    from_status TEXT,
+    to_status TEXT NOT NULL,
+    actor_id UUID,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_order_events_order_id ON order_events(order_id, created_at);
+
+-- earlier transitions were not recorded; start each timeline at the current status
+INSERT INTO order_events (order_id, to_status, reason, created_at)
+SELECT o.id, o.status, 'status before history was recorded', o.updated_at
+FROM orders o
+WHERE NOT EXISTS (SELECT 1 FROM order_events e WHERE e.order_id = o.id);
@@ -0,0 +1,23 @@
+-- Invoices of orders, one per order
+CREATE TABLE IF NOT EXISTS invoices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    number BIGINT NOT NULL UNIQUE,
+    order_id UUID NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    subtotal_cents BIGINT NOT NULL,
+    discount_cents BIGINT NOT NULL DEFAULT 0,
+    tax_cents BIGINT NOT NULL DEFAULT 0,
+    shipping_cents BIGINT NOT NULL DEFAULT 0,
+    total_cents BIGINT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_invoices_user_id ON invoices(user_id);
+
+-- The last invoice number issued. Issuing locks this single row, so numbers
+-- are handed out one at a time and a rolled back issue leaves no gap
+-- (a sequence would).
+CREATE TABLE IF NOT EXISTS invoice_numbers (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+INSERT INTO invoice_numbers (id, last_number) VALUES (TRUE, 0) ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,2 @@
+-- the product name is a snapshot: renaming or deleting a product never changes placed orders
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS product_name TEXT NOT NULL DEFAULT '';
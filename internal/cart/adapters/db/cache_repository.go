@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/pkg/cache"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type cachedCartRepository struct {
+	baseRepository CartRepository
+	cacheClient    *cache.Client
+	cacheTTL       time.Duration
+	tracer         observability.Tracer
+}
+
+func NewRepository(database *appdb.Database, t observability.Tracer, c *cache.Client) (CartRepository, error) {
+	// fallback to db-only if cache is nil
+	baseRepository, err := NewDBRepository(database, t)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return baseRepository, nil
+	}
+	return &cachedCartRepository{baseRepository: baseRepository, cacheClient: c, cacheTTL: 5 * time.Minute, tracer: t}, nil
+}
+
+func (r *cachedCartRepository) GetByUser(ctx context.Context, userID string) (domain.Cart, error) {
+	if data, _ := r.cacheClient.Get(ctx, r.keyByUser(userID)); data != nil {
+		var cachedCart domain.Cart
+		if err := json.Unmarshal(data, &cachedCart); err == nil {
+			return cachedCart, nil
+		}
+	}
+
+	cart, err := r.baseRepository.GetByUser(ctx, userID)
+	if err != nil {
+		return cart, err
+	}
+
+	if encoded, err := json.Marshal(cart); err == nil {
+		_ = r.cacheClient.Set(ctx, r.keyByUser(userID), encoded, r.cacheTTL)
+	}
+
+	return cart, nil
+}
+
+func (r *cachedCartRepository) SetItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error) {
+	cart, err := r.baseRepository.SetItem(ctx, userID, productID, quantity)
+	_ = r.cacheClient.Del(ctx, r.keyByUser(userID))
+	return cart, err
+}
+
+func (r *cachedCartRepository) RemoveItem(ctx context.Context, userID string, productID string) (domain.Cart, error) {
+	cart, err := r.baseRepository.RemoveItem(ctx, userID, productID)
+	_ = r.cacheClient.Del(ctx, r.keyByUser(userID))
+	return cart, err
+}
+
+func (r *cachedCartRepository) Clear(ctx context.Context, userID string) error {
+	err := r.baseRepository.Clear(ctx, userID)
+	_ = r.cacheClient.Del(ctx, r.keyByUser(userID))
+	return err
+}
+
+func (r *cachedCartRepository) keyByUser(userID string) string {
+	return fmt.Sprintf("cart:user:%s", userID)
+}
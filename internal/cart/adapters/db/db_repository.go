@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/cart/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbCartRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (CartRepository, error) {
+	return &dbCartRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbCartRepository) GetByUser(ctx context.Context, userID string) (domain.Cart, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "CartRepository.GetByUser")
+	defer span.End()
+
+	var cart domain.Cart
+	err := appdb.Conn(ctx, r.db).Table("carts").Where("user_id = ?", userID).First(&cart).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Cart{UserID: userID, Items: []domain.CartItem{}}, nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	var items []domain.CartItem
+	if err := appdb.Conn(ctx, r.db).Table("cart_items").Where("cart_id = ?", cart.ID).Order("created_at asc").Find(&items).Error; err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+	cart.Items = items
+
+	return cart, nil
+}
+
+func (r *dbCartRepository) SetItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "CartRepository.SetItem")
+	defer span.End()
+
+	now := time.Now().UTC()
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var cart struct{ ID string }
+		if err := tx.Raw(`INSERT INTO carts (user_id, created_at, updated_at) VALUES (?, ?, ?)
+			ON CONFLICT (user_id) DO UPDATE SET updated_at = EXCLUDED.updated_at
+			RETURNING id`, userID, now, now).Scan(&cart).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(`INSERT INTO cart_items (cart_id, product_id, quantity, created_at, updated_at) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (cart_id, product_id) DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = EXCLUDED.updated_at`,
+			cart.ID, productID, quantity, now, now).Error
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	return r.GetByUser(ctx, userID)
+}
+
+func (r *dbCartRepository) RemoveItem(ctx context.Context, userID string, productID string) (domain.Cart, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "CartRepository.RemoveItem")
+	defer span.End()
+
+	tx := appdb.Conn(ctx, r.db).Exec(`DELETE FROM cart_items ci USING carts c
+		WHERE ci.cart_id = c.id AND c.user_id = ? AND ci.product_id = ?`, userID, productID)
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return domain.Cart{}, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return domain.Cart{}, gorm.ErrRecordNotFound
+	}
+
+	return r.GetByUser(ctx, userID)
+}
+
+func (r *dbCartRepository) Clear(ctx context.Context, userID string) error {
+	ctx, span := r.tracer.StartSpan(ctx, "CartRepository.Clear")
+	defer span.End()
+
+	if err := appdb.Conn(ctx, r.db).Exec(`DELETE FROM cart_items ci USING carts c
+		WHERE ci.cart_id = c.id AND c.user_id = ?`, userID).Error; err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/cart/domain"
+)
+
+type CartRepository interface {
+	// GetByUser returns the user's cart; a user without one gets an empty cart.
+	GetByUser(ctx context.Context, userID string) (domain.Cart, error)
+	// SetItem creates the cart if needed and sets the product quantity.
+	SetItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error)
+	RemoveItem(ctx context.Context, userID string, productID string) (domain.Cart, error)
+	Clear(ctx context.Context, userID string) error
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/cart/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/cart/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCartRepository is a mock of CartRepository interface.
+type MockCartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartRepositoryMockRecorder
+}
+
+// MockCartRepositoryMockRecorder is the mock recorder for MockCartRepository.
+type MockCartRepositoryMockRecorder struct {
+	mock *MockCartRepository
+}
+
+// NewMockCartRepository creates a new mock instance.
+func NewMockCartRepository(ctrl *gomock.Controller) *MockCartRepository {
+	mock := &MockCartRepository{ctrl: ctrl}
+	mock.recorder = &MockCartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockCartRepository) Clear(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockCartRepositoryMockRecorder) Clear(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockCartRepository)(nil).Clear), ctx, userID)
+}
+
+// GetByUser mocks base method.
+func (m *MockCartRepository) GetByUser(ctx context.Context, userID string) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", ctx, userID)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockCartRepositoryMockRecorder) GetByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockCartRepository)(nil).GetByUser), ctx, userID)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCartRepository) RemoveItem(ctx context.Context, userID, productID string) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, userID, productID)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCartRepositoryMockRecorder) RemoveItem(ctx, userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCartRepository)(nil).RemoveItem), ctx, userID, productID)
+}
+
+// SetItem mocks base method.
+func (m *MockCartRepository) SetItem(ctx context.Context, userID, productID string, quantity int64) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItem", ctx, userID, productID, quantity)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetItem indicates an expected call of SetItem.
+func (mr *MockCartRepositoryMockRecorder) SetItem(ctx, userID, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItem", reflect.TypeOf((*MockCartRepository)(nil).SetItem), ctx, userID, productID, quantity)
+}
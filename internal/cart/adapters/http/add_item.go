@@ -0,0 +1,85 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type AddItemHandler struct {
+	service   command.AddItemService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewAddItemHandler(s command.AddItemService, v *validator.Validate, t observability.Tracer) (AddItemHandler, error) {
+	return AddItemHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type addItemRequest struct {
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	Quantity  int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+// Add Cart Item
+// @Summary      Add item to cart
+// @Description  Add a product to the authenticated user's cart, summing with any quantity already there
+// @Tags         Cart
+// @Accept       json
+// @Produce      json
+// @Param        item  body     addItemRequest  true  "Item input"
+// @Success      200   {object} domain.Cart
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      404   {object} map[string]string "Product Not Found"
+// @Failure      409   {object} map[string]string "Insufficient stock"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /cart/items [post]
+func (h AddItemHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "CartHTTP.AddItem")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	var req addItemRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	cart, err := h.service.AddItem(ctx, userID, req.ProductID, req.Quantity)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, cart)
+}
+
+// writeError maps cart errors to HTTP responses.
+func writeError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	case errors.Is(err, domain.ErrInsufficientStock):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/command"
+	"r2-challenge/internal/order/adapters/shipping"
+	orderdomain "r2-challenge/internal/order/domain"
+	promodomain "r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type CheckoutHandler struct {
+	service   command.CheckoutService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCheckoutHandler(s command.CheckoutService, v *validator.Validate, t observability.Tracer) (CheckoutHandler, error) {
+	return CheckoutHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type checkoutRequest struct {
+	CouponCode string `json:"coupon_code" validate:"omitempty,max=64"`
+	// the default address is used when neither is set
+	ShippingAddressID string               `json:"shipping_address_id" validate:"omitempty,uuid"`
+	ShippingAddress   *orderdomain.Address `json:"shipping_address"`
+	ShippingMethod    string               `json:"shipping_method" validate:"omitempty,max=32"`
+}
+
+// Checkout Cart
+// @Summary      Checkout cart
+// @Description  Place an order with the authenticated user's cart and empty it
+// @Tags         Cart
+// @Accept       json
+// @Produce      json
+// @Param        checkout  body  checkoutRequest  false  "Optional coupon and shipping details"
+// @Success      201  {object} orderdomain.Order
+// @Failure      400  {object} map[string]string "Empty cart or missing shipping address"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      402  {object} map[string]string "Payment Required"
+// @Failure      409  {object} map[string]string "Price changed"
+// @Failure      422  {object} map[string]string "Coupon cannot be applied"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /cart/checkout [post]
+func (h CheckoutHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "CartHTTP.Checkout")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	// the body is optional
+	var req checkoutRequest
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&req); err != nil {
+			span.RecordError(err)
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+		}
+		if err := h.validator.Struct(req); err != nil {
+			span.RecordError(err)
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+	}
+
+	order, err := h.service.Checkout(ctx, userID, command.CheckoutOptions{
+		CouponCode: req.CouponCode, ShippingAddressID: req.ShippingAddressID,
+		ShippingAddress: req.ShippingAddress, ShippingMethod: req.ShippingMethod,
+	})
+	if err != nil {
+		span.RecordError(err)
+		var priceErr orderdomain.PriceChangedError
+		switch {
+		case errors.Is(err, domain.ErrEmptyCart):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		case errors.As(err, &priceErr), errors.Is(err, domain.ErrUnavailableItem):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		case errors.Is(err, orderdomain.ErrPaymentFailed):
+			return c.JSON(http.StatusPaymentRequired, map[string]string{"error": err.Error()})
+		case errors.Is(err, orderdomain.ErrPaymentPending):
+			// the order exists; its payment is settled later
+			return c.JSON(http.StatusAccepted, order)
+		case errors.Is(err, promodomain.ErrCouponNotApplicable):
+			return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+		case errors.Is(err, orderdomain.ErrInvalidShippingAddress), errors.Is(err, shipping.ErrUnknownMethod):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, order)
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/cart/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type GetCartHandler struct {
+	service   query.GetCartService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetCartHandler(s query.GetCartService, v *validator.Validate, t observability.Tracer) (GetCartHandler, error) {
+	return GetCartHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get My Cart
+// @Summary      Get my cart
+// @Description  Get the authenticated user's cart with live prices and stock
+// @Tags         Cart
+// @Produce      json
+// @Success      200  {object} domain.Cart
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /cart [get]
+func (h GetCartHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "CartHTTP.Get")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	cart, err := h.service.Get(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, cart)
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/cart/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type RemoveItemHandler struct {
+	service   command.RemoveItemService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewRemoveItemHandler(s command.RemoveItemService, v *validator.Validate, t observability.Tracer) (RemoveItemHandler, error) {
+	return RemoveItemHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Remove Cart Item
+// @Summary      Remove cart item
+// @Description  Remove a product from the authenticated user's cart
+// @Tags         Cart
+// @Produce      json
+// @Param        productId  path     string  true  "Product ID"
+// @Success      200        {object} domain.Cart
+// @Failure      400        {object} map[string]string "Bad Request"
+// @Failure      401        {object} map[string]string "Unauthorized"
+// @Failure      404        {object} map[string]string "Not Found"
+// @Failure      500        {object} map[string]string "Internal Server Error"
+// @Router       /cart/items/{productId} [delete]
+func (h RemoveItemHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "CartHTTP.RemoveItem")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	productID := c.Param("productId")
+	if err := h.validator.Var(productID, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid product id"})
+	}
+
+	cart, err := h.service.RemoveItem(ctx, userID, productID)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, cart)
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/cart/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateItemHandler struct {
+	service   command.UpdateItemService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewUpdateItemHandler(s command.UpdateItemService, v *validator.Validate, t observability.Tracer) (UpdateItemHandler, error) {
+	return UpdateItemHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type updateItemRequest struct {
+	Quantity int64 `json:"quantity" validate:"required,gt=0"`
+}
+
+// Update Cart Item
+// @Summary      Update cart item
+// @Description  Set the quantity of a product in the authenticated user's cart
+// @Tags         Cart
+// @Accept       json
+// @Produce      json
+// @Param        productId  path     string             true  "Product ID"
+// @Param        item       body     updateItemRequest  true  "Quantity input"
+// @Success      200        {object} domain.Cart
+// @Failure      400        {object} map[string]string "Bad Request"
+// @Failure      401        {object} map[string]string "Unauthorized"
+// @Failure      404        {object} map[string]string "Product Not Found"
+// @Failure      409        {object} map[string]string "Insufficient stock"
+// @Failure      500        {object} map[string]string "Internal Server Error"
+// @Router       /cart/items/{productId} [put]
+func (h UpdateItemHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "CartHTTP.UpdateItem")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	productID := c.Param("productId")
+	if err := h.validator.Var(productID, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid product id"})
+	}
+
+	var req updateItemRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	cart, err := h.service.UpdateItem(ctx, userID, productID, req.Quantity)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, cart)
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrEmptyCart is returned when checking out a cart without items.
+var ErrEmptyCart = errors.New("cart is empty")
+
+// ErrUnavailableItem is returned when checking out a cart with a line whose
+// product no longer exists in the catalog.
+var ErrUnavailableItem = errors.New("cart has items that are no longer available")
+
+// ErrInsufficientStock is returned when a cart item asks for more than the product has in stock.
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// Cart is the core domain type for shopping carts; each user has at most one.
+type Cart struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id" validate:"required"`
+	Items      []CartItem `json:"items" gorm:"-"`
+	TotalCents int64      `json:"total_cents" gorm:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// CartItem is a product line in a cart. Name, price and stock are not stored;
+// they are filled from the product catalog whenever the cart is read.
+// Available is the stock the user can still order: what other users'
+// reservations leave, including the user's own holds. Unavailable marks a
+// line whose product is gone from the catalog; it is left out of the total.
+type CartItem struct {
+	ID          string    `json:"id"`
+	CartID      string    `json:"cart_id"`
+	ProductID   string    `json:"product_id" validate:"required"`
+	Quantity    int64     `json:"quantity" validate:"required,gt=0"`
+	ProductName string    `json:"product_name" gorm:"-"`
+	PriceCents  int64     `json:"price_cents" gorm:"-"`
+	Available   int64     `json:"available" gorm:"-"`
+	Unavailable bool      `json:"unavailable" gorm:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/query"
+	productdb "r2-challenge/internal/product/adapters/db"
+	reservationdb "r2-challenge/internal/reservation/adapters/db"
+	reservationdomain "r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type AddItemService interface {
+	// AddItem adds quantity units of the product to the user's cart.
+	AddItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error)
+}
+
+type addItemService struct {
+	repo     repo.CartRepository
+	products productdb.ProductRepository
+	holds    reservationdb.ReservationRepository
+	view     query.GetCartService
+	tracer   observability.Tracer
+}
+
+func NewAddItemService(r repo.CartRepository, p productdb.ProductRepository, h reservationdb.ReservationRepository, v query.GetCartService, t observability.Tracer) (AddItemService, error) {
+	return &addItemService{repo: r, products: p, holds: h, view: v, tracer: t}, nil
+}
+
+func (s *addItemService) AddItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "CartCommand.AddItem")
+	defer span.End()
+
+	product, err := s.products.GetByID(ctx, productID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	cart, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	total := quantity
+	for _, it := range cart.Items {
+		if it.ProductID == productID {
+			total += it.Quantity
+		}
+	}
+
+	holds, err := s.holds.ListActiveByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	// the user's own holds count as stock for them, as they do at checkout
+	if total > product.Available+reservationdomain.Held(holds)[productID] {
+		span.RecordError(domain.ErrInsufficientStock)
+		return domain.Cart{}, domain.ErrInsufficientStock
+	}
+
+	if _, err := s.repo.SetItem(ctx, userID, productID, total); err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	return s.view.Get(ctx, userID)
+}
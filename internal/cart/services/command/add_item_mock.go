@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/cart/services/command/add_item.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/cart/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddItemService is a mock of AddItemService interface.
+type MockAddItemService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddItemServiceMockRecorder
+}
+
+// MockAddItemServiceMockRecorder is the mock recorder for MockAddItemService.
+type MockAddItemServiceMockRecorder struct {
+	mock *MockAddItemService
+}
+
+// NewMockAddItemService creates a new mock instance.
+func NewMockAddItemService(ctrl *gomock.Controller) *MockAddItemService {
+	mock := &MockAddItemService{ctrl: ctrl}
+	mock.recorder = &MockAddItemServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddItemService) EXPECT() *MockAddItemServiceMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockAddItemService) AddItem(ctx context.Context, userID, productID string, quantity int64) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, userID, productID, quantity)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockAddItemServiceMockRecorder) AddItem(ctx, userID, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockAddItemService)(nil).AddItem), ctx, userID, productID, quantity)
+}
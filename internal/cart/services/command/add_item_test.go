@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	cartdb "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/query"
+	productdb "r2-challenge/internal/product/adapters/db"
+	productdomain "r2-challenge/internal/product/domain"
+	reservationdb "r2-challenge/internal/reservation/adapters/db"
+	reservationdomain "r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestAddItem_SumsWithExistingQuantity(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	products := productdb.NewMockProductRepository(ctrl)
+	holds := reservationdb.NewMockReservationRepository(ctrl)
+	view := query.NewMockGetCartService(ctrl)
+	s, _ := NewAddItemService(repo, products, holds, view, tracer)
+
+	products.EXPECT().GetByID(gomock.Any(), "p1").Return(productdomain.Product{ID: "p1", Inventory: 5, Available: 5}, nil)
+	repo.EXPECT().GetByUser(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1", Items: []domain.CartItem{{ProductID: "p1", Quantity: 2}}}, nil)
+	holds.EXPECT().ListActiveByUser(gomock.Any(), "u1").Return(nil, nil)
+	repo.EXPECT().SetItem(gomock.Any(), "u1", "p1", int64(5)).Return(domain.Cart{}, nil)
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1"}, nil)
+
+	if _, err := s.AddItem(context.Background(), "u1", "p1", 3); err != nil {
+		t.Fatalf("AddItem failed: %v", err)
+	}
+}
+
+func TestAddItem_RejectsMoreThanStock(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	products := productdb.NewMockProductRepository(ctrl)
+	holds := reservationdb.NewMockReservationRepository(ctrl)
+	s, _ := NewAddItemService(repo, products, holds, query.NewMockGetCartService(ctrl), tracer)
+
+	products.EXPECT().GetByID(gomock.Any(), "p1").Return(productdomain.Product{ID: "p1", Inventory: 2, Available: 2}, nil)
+	repo.EXPECT().GetByUser(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1"}, nil)
+	holds.EXPECT().ListActiveByUser(gomock.Any(), "u1").Return(nil, nil)
+
+	if _, err := s.AddItem(context.Background(), "u1", "p1", 3); !errors.Is(err, domain.ErrInsufficientStock) {
+		t.Fatalf("expected ErrInsufficientStock, got %v", err)
+	}
+}
+
+func TestAddItem_StockHeldByOthersIsNotAvailable(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	products := productdb.NewMockProductRepository(ctrl)
+	holds := reservationdb.NewMockReservationRepository(ctrl)
+	s, _ := NewAddItemService(repo, products, holds, query.NewMockGetCartService(ctrl), tracer)
+
+	products.EXPECT().GetByID(gomock.Any(), "p1").Return(productdomain.Product{ID: "p1", Inventory: 10, Available: 2}, nil)
+	repo.EXPECT().GetByUser(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1"}, nil)
+	holds.EXPECT().ListActiveByUser(gomock.Any(), "u1").Return(nil, nil)
+
+	if _, err := s.AddItem(context.Background(), "u1", "p1", 3); !errors.Is(err, domain.ErrInsufficientStock) {
+		t.Fatalf("expected ErrInsufficientStock, got %v", err)
+	}
+}
+
+func TestAddItem_OwnHoldsCountAsStock(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	products := productdb.NewMockProductRepository(ctrl)
+	holds := reservationdb.NewMockReservationRepository(ctrl)
+	view := query.NewMockGetCartService(ctrl)
+	s, _ := NewAddItemService(repo, products, holds, view, tracer)
+
+	products.EXPECT().GetByID(gomock.Any(), "p1").Return(productdomain.Product{ID: "p1", Inventory: 10, Available: 0}, nil)
+	repo.EXPECT().GetByUser(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1"}, nil)
+	holds.EXPECT().ListActiveByUser(gomock.Any(), "u1").Return([]reservationdomain.Reservation{
+		{ProductID: "p1", Quantity: 2}, {ProductID: "p1", Quantity: 1}, {ProductID: "p2", Quantity: 4},
+	}, nil)
+	repo.EXPECT().SetItem(gomock.Any(), "u1", "p1", int64(3)).Return(domain.Cart{}, nil)
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1"}, nil)
+
+	if _, err := s.AddItem(context.Background(), "u1", "p1", 3); err != nil {
+		t.Fatalf("AddItem failed: %v", err)
+	}
+}
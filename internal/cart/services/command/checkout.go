@@ -0,0 +1,85 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	repo "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/query"
+	orderdomain "r2-challenge/internal/order/domain"
+	ordercmd "r2-challenge/internal/order/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type CheckoutService interface {
+	// Checkout places an order with the cart contents and empties the cart.
+	// A cart with unavailable lines is refused with domain.ErrUnavailableItem.
+	// An order whose payment outcome is unknown is placed all the same: the
+	// cart is emptied and the order is returned with ErrPaymentPending.
+	Checkout(ctx context.Context, userID string, opts CheckoutOptions) (orderdomain.Order, error)
+}
+
+// CheckoutOptions are the optional order details sent at checkout; see
+// ordercmd.PlaceOrderService for how the shipping address is chosen.
+type CheckoutOptions struct {
+	CouponCode        string
+	ShippingAddressID string
+	ShippingAddress   *orderdomain.Address
+	ShippingMethod    string
+}
+
+type checkoutService struct {
+	repo   repo.CartRepository
+	view   query.GetCartService
+	orders ordercmd.PlaceOrderService
+	tracer observability.Tracer
+}
+
+func NewCheckoutService(r repo.CartRepository, v query.GetCartService, o ordercmd.PlaceOrderService, t observability.Tracer) (CheckoutService, error) {
+	return &checkoutService{repo: r, view: v, orders: o, tracer: t}, nil
+}
+
+func (s *checkoutService) Checkout(ctx context.Context, userID string, opts CheckoutOptions) (orderdomain.Order, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "CartCommand.Checkout")
+	defer span.End()
+
+	cart, err := s.view.Get(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return orderdomain.Order{}, err
+	}
+
+	if len(cart.Items) == 0 {
+		span.RecordError(domain.ErrEmptyCart)
+		return orderdomain.Order{}, domain.ErrEmptyCart
+	}
+
+	// the prices shown in the cart become the expected prices, so a catalog
+	// change in between is reported instead of silently charged
+	items := make([]orderdomain.OrderItem, 0, len(cart.Items))
+	for _, it := range cart.Items {
+		if it.Unavailable {
+			span.RecordError(domain.ErrUnavailableItem)
+			return orderdomain.Order{}, domain.ErrUnavailableItem
+		}
+		items = append(items, orderdomain.OrderItem{ProductID: it.ProductID, Quantity: it.Quantity, ExpectedPriceCents: it.PriceCents})
+	}
+
+	placed, err := s.orders.Place(ctx, orderdomain.Order{
+		UserID: userID, Items: items, Status: orderdomain.StatusCreated,
+		CouponCode: opts.CouponCode, ShippingAddressID: opts.ShippingAddressID,
+		ShippingAddress: opts.ShippingAddress, ShippingMethod: opts.ShippingMethod,
+	})
+	if err != nil && !errors.Is(err, orderdomain.ErrPaymentPending) {
+		span.RecordError(err)
+		return orderdomain.Order{}, err
+	}
+
+	if err := s.repo.Clear(ctx, userID); err != nil {
+		// the order is placed; a stale cart is not worth failing the request
+		span.RecordError(err)
+	}
+
+	return placed, err
+}
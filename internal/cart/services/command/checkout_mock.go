@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/cart/services/command/checkout.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCheckoutService is a mock of CheckoutService interface.
+type MockCheckoutService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckoutServiceMockRecorder
+}
+
+// MockCheckoutServiceMockRecorder is the mock recorder for MockCheckoutService.
+type MockCheckoutServiceMockRecorder struct {
+	mock *MockCheckoutService
+}
+
+// NewMockCheckoutService creates a new mock instance.
+func NewMockCheckoutService(ctrl *gomock.Controller) *MockCheckoutService {
+	mock := &MockCheckoutService{ctrl: ctrl}
+	mock.recorder = &MockCheckoutServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckoutService) EXPECT() *MockCheckoutServiceMockRecorder {
+	return m.recorder
+}
+
+// Checkout mocks base method.
+func (m *MockCheckoutService) Checkout(ctx context.Context, userID string, opts CheckoutOptions) (domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, userID, opts)
+	ret0, _ := ret[0].(domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockCheckoutServiceMockRecorder) Checkout(ctx, userID, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockCheckoutService)(nil).Checkout), ctx, userID, opts)
+}
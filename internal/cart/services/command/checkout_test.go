@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	cartdb "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/query"
+	orderdomain "r2-challenge/internal/order/domain"
+	ordercmd "r2-challenge/internal/order/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+func TestCheckout_PlacesOrderAndClearsCart(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	view := query.NewMockGetCartService(ctrl)
+	orders := ordercmd.NewMockPlaceOrderService(ctrl)
+	s, _ := NewCheckoutService(repo, view, orders, tracer)
+
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1", Items: []domain.CartItem{{ProductID: "p1", Quantity: 2, PriceCents: 990}}}, nil)
+	orders.EXPECT().Place(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o orderdomain.Order) (orderdomain.Order, error) {
+		if len(o.Items) != 1 || o.Items[0].Quantity != 2 || o.Items[0].ExpectedPriceCents != 990 || o.CouponCode != "SAVE10" || o.ShippingAddressID != "addr_1" || o.ShippingMethod != "flat" {
+			t.Fatalf("unexpected order items: %+v", o.Items)
+		}
+		o.ID = "ord_1"
+		return o, nil
+	})
+	repo.EXPECT().Clear(gomock.Any(), "u1").Return(nil)
+
+	placed, err := s.Checkout(context.Background(), "u1", CheckoutOptions{CouponCode: "SAVE10", ShippingAddressID: "addr_1", ShippingMethod: "flat"})
+	if err != nil {
+		t.Fatalf("Checkout failed: %v", err)
+	}
+	if placed.ID != "ord_1" {
+		t.Fatalf("unexpected order: %+v", placed)
+	}
+}
+
+func TestCheckout_EmptyCart(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	view := query.NewMockGetCartService(ctrl)
+	s, _ := NewCheckoutService(cartdb.NewMockCartRepository(ctrl), view, ordercmd.NewMockPlaceOrderService(ctrl), tracer)
+
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1"}, nil)
+
+	if _, err := s.Checkout(context.Background(), "u1", CheckoutOptions{}); !errors.Is(err, domain.ErrEmptyCart) {
+		t.Fatalf("expected ErrEmptyCart, got %v", err)
+	}
+}
+
+func TestCheckout_RefusesUnavailableItems(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	view := query.NewMockGetCartService(ctrl)
+	s, _ := NewCheckoutService(cartdb.NewMockCartRepository(ctrl), view, ordercmd.NewMockPlaceOrderService(ctrl), tracer)
+
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1", Items: []domain.CartItem{
+		{ProductID: "p1", Quantity: 1, PriceCents: 990}, {ProductID: "p2", Quantity: 1, Unavailable: true},
+	}}, nil)
+
+	if _, err := s.Checkout(context.Background(), "u1", CheckoutOptions{}); !errors.Is(err, domain.ErrUnavailableItem) {
+		t.Fatalf("expected ErrUnavailableItem, got %v", err)
+	}
+}
+
+func TestCheckout_KeepsCartWhenPlacementFails(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	view := query.NewMockGetCartService(ctrl)
+	orders := ordercmd.NewMockPlaceOrderService(ctrl)
+	s, _ := NewCheckoutService(cartdb.NewMockCartRepository(ctrl), view, orders, tracer)
+
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1", Items: []domain.CartItem{{ProductID: "p1", Quantity: 1}}}, nil)
+	orders.EXPECT().Place(gomock.Any(), gomock.Any()).Return(orderdomain.Order{}, orderdomain.ErrPaymentFailed)
+
+	if _, err := s.Checkout(context.Background(), "u1", CheckoutOptions{}); !errors.Is(err, orderdomain.ErrPaymentFailed) {
+		t.Fatalf("expected ErrPaymentFailed, got %v", err)
+	}
+}
+
+func TestCheckout_PendingPaymentStillClearsCart(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	view := query.NewMockGetCartService(ctrl)
+	orders := ordercmd.NewMockPlaceOrderService(ctrl)
+	s, _ := NewCheckoutService(repo, view, orders, tracer)
+
+	view.EXPECT().Get(gomock.Any(), "u1").Return(domain.Cart{UserID: "u1", Items: []domain.CartItem{{ProductID: "p1", Quantity: 1}}}, nil)
+	orders.EXPECT().Place(gomock.Any(), gomock.Any()).Return(orderdomain.Order{ID: "ord_1"}, orderdomain.ErrPaymentPending)
+	repo.EXPECT().Clear(gomock.Any(), "u1").Return(nil)
+
+	placed, err := s.Checkout(context.Background(), "u1", CheckoutOptions{})
+	if !errors.Is(err, orderdomain.ErrPaymentPending) || placed.ID != "ord_1" {
+		t.Fatalf("expected the placed order with ErrPaymentPending, got %+v, %v", placed, err)
+	}
+}
@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type RemoveItemService interface {
+	RemoveItem(ctx context.Context, userID string, productID string) (domain.Cart, error)
+}
+
+type removeItemService struct {
+	repo   repo.CartRepository
+	view   query.GetCartService
+	tracer observability.Tracer
+}
+
+func NewRemoveItemService(r repo.CartRepository, v query.GetCartService, t observability.Tracer) (RemoveItemService, error) {
+	return &removeItemService{repo: r, view: v, tracer: t}, nil
+}
+
+func (s *removeItemService) RemoveItem(ctx context.Context, userID string, productID string) (domain.Cart, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "CartCommand.RemoveItem")
+	defer span.End()
+
+	if _, err := s.repo.RemoveItem(ctx, userID, productID); err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	return s.view.Get(ctx, userID)
+}
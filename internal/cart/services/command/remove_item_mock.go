@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/cart/services/command/remove_item.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/cart/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRemoveItemService is a mock of RemoveItemService interface.
+type MockRemoveItemService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveItemServiceMockRecorder
+}
+
+// MockRemoveItemServiceMockRecorder is the mock recorder for MockRemoveItemService.
+type MockRemoveItemServiceMockRecorder struct {
+	mock *MockRemoveItemService
+}
+
+// NewMockRemoveItemService creates a new mock instance.
+func NewMockRemoveItemService(ctrl *gomock.Controller) *MockRemoveItemService {
+	mock := &MockRemoveItemService{ctrl: ctrl}
+	mock.recorder = &MockRemoveItemServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveItemService) EXPECT() *MockRemoveItemServiceMockRecorder {
+	return m.recorder
+}
+
+// RemoveItem mocks base method.
+func (m *MockRemoveItemService) RemoveItem(ctx context.Context, userID, productID string) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, userID, productID)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockRemoveItemServiceMockRecorder) RemoveItem(ctx, userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockRemoveItemService)(nil).RemoveItem), ctx, userID, productID)
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	"r2-challenge/internal/cart/services/query"
+	productdb "r2-challenge/internal/product/adapters/db"
+	reservationdb "r2-challenge/internal/reservation/adapters/db"
+	reservationdomain "r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateItemService interface {
+	// UpdateItem sets the quantity of the product in the user's cart.
+	UpdateItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error)
+}
+
+type updateItemService struct {
+	repo     repo.CartRepository
+	products productdb.ProductRepository
+	holds    reservationdb.ReservationRepository
+	view     query.GetCartService
+	tracer   observability.Tracer
+}
+
+func NewUpdateItemService(r repo.CartRepository, p productdb.ProductRepository, h reservationdb.ReservationRepository, v query.GetCartService, t observability.Tracer) (UpdateItemService, error) {
+	return &updateItemService{repo: r, products: p, holds: h, view: v, tracer: t}, nil
+}
+
+func (s *updateItemService) UpdateItem(ctx context.Context, userID string, productID string, quantity int64) (domain.Cart, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "CartCommand.UpdateItem")
+	defer span.End()
+
+	product, err := s.products.GetByID(ctx, productID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	holds, err := s.holds.ListActiveByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	// the user's own holds count as stock for them, as they do at checkout
+	if quantity > product.Available+reservationdomain.Held(holds)[productID] {
+		span.RecordError(domain.ErrInsufficientStock)
+		return domain.Cart{}, domain.ErrInsufficientStock
+	}
+
+	if _, err := s.repo.SetItem(ctx, userID, productID, quantity); err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	return s.view.Get(ctx, userID)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/cart/services/command/update_item.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/cart/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateItemService is a mock of UpdateItemService interface.
+type MockUpdateItemService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateItemServiceMockRecorder
+}
+
+// MockUpdateItemServiceMockRecorder is the mock recorder for MockUpdateItemService.
+type MockUpdateItemServiceMockRecorder struct {
+	mock *MockUpdateItemService
+}
+
+// NewMockUpdateItemService creates a new mock instance.
+func NewMockUpdateItemService(ctrl *gomock.Controller) *MockUpdateItemService {
+	mock := &MockUpdateItemService{ctrl: ctrl}
+	mock.recorder = &MockUpdateItemServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateItemService) EXPECT() *MockUpdateItemServiceMockRecorder {
+	return m.recorder
+}
+
+// UpdateItem mocks base method.
+func (m *MockUpdateItemService) UpdateItem(ctx context.Context, userID, productID string, quantity int64) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, userID, productID, quantity)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockUpdateItemServiceMockRecorder) UpdateItem(ctx, userID, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockUpdateItemService)(nil).UpdateItem), ctx, userID, productID, quantity)
+}
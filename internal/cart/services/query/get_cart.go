@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	repo "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	productdb "r2-challenge/internal/product/adapters/db"
+	reservationdb "r2-challenge/internal/reservation/adapters/db"
+	reservationdomain "r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetCartService interface {
+	// Get returns the user's cart with live prices and stock from the catalog;
+	// stock counts the user's own reservations as theirs. Lines whose product
+	// no longer exists are marked unavailable instead of failing the cart.
+	Get(ctx context.Context, userID string) (domain.Cart, error)
+}
+
+type service struct {
+	repo     repo.CartRepository
+	products productdb.ProductRepository
+	holds    reservationdb.ReservationRepository
+	tracer   observability.Tracer
+}
+
+func NewGetCartService(r repo.CartRepository, p productdb.ProductRepository, h reservationdb.ReservationRepository, t observability.Tracer) (GetCartService, error) {
+	return &service{repo: r, products: p, holds: h, tracer: t}, nil
+}
+
+func (s *service) Get(ctx context.Context, userID string) (domain.Cart, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "CartQuery.Get")
+	defer span.End()
+
+	cart, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+
+	holds, err := s.holds.ListActiveByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Cart{}, err
+	}
+	held := reservationdomain.Held(holds)
+
+	cart.TotalCents = 0
+	for i := range cart.Items {
+		product, err := s.products.GetByID(ctx, cart.Items[i].ProductID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			cart.Items[i].Unavailable = true
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			return domain.Cart{}, err
+		}
+		cart.Items[i].ProductName = product.Name
+		cart.Items[i].PriceCents = product.PriceCents
+		cart.Items[i].Available = product.Available + held[product.ID]
+		cart.TotalCents += product.PriceCents * cart.Items[i].Quantity
+	}
+
+	return cart, nil
+}
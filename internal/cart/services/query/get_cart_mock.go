@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/cart/services/query/get_cart.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/cart/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetCartService is a mock of GetCartService interface.
+type MockGetCartService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCartServiceMockRecorder
+}
+
+// MockGetCartServiceMockRecorder is the mock recorder for MockGetCartService.
+type MockGetCartServiceMockRecorder struct {
+	mock *MockGetCartService
+}
+
+// NewMockGetCartService creates a new mock instance.
+func NewMockGetCartService(ctrl *gomock.Controller) *MockGetCartService {
+	mock := &MockGetCartService{ctrl: ctrl}
+	mock.recorder = &MockGetCartServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCartService) EXPECT() *MockGetCartServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGetCartService) Get(ctx context.Context, userID string) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGetCartServiceMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetCartService)(nil).Get), ctx, userID)
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+
+	cartdb "r2-challenge/internal/cart/adapters/db"
+	"r2-challenge/internal/cart/domain"
+	productdb "r2-challenge/internal/product/adapters/db"
+	productdomain "r2-challenge/internal/product/domain"
+	reservationdb "r2-challenge/internal/reservation/adapters/db"
+	reservationdomain "r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestGetCart_FillsLivePrices(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	products := productdb.NewMockProductRepository(ctrl)
+	holds := reservationdb.NewMockReservationRepository(ctrl)
+	s, _ := NewGetCartService(repo, products, holds, tracer)
+
+	repo.EXPECT().GetByUser(gomock.Any(), "u1").Return(domain.Cart{ID: "c1", UserID: "u1", Items: []domain.CartItem{{ProductID: "p1", Quantity: 3}}}, nil)
+	holds.EXPECT().ListActiveByUser(gomock.Any(), "u1").Return([]reservationdomain.Reservation{{ProductID: "p1", Quantity: 2}}, nil)
+	products.EXPECT().GetByID(gomock.Any(), "p1").Return(productdomain.Product{ID: "p1", Name: "Mug", PriceCents: 1990, Inventory: 12, Available: 5}, nil)
+
+	cart, err := s.Get(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if cart.Items[0].PriceCents != 1990 || cart.Items[0].Available != 7 || cart.Items[0].ProductName != "Mug" {
+		t.Fatalf("item not enriched: %+v", cart.Items[0])
+	}
+	if cart.TotalCents != 5970 {
+		t.Fatalf("unexpected total: %d", cart.TotalCents)
+	}
+}
+
+func TestGetCart_MarksMissingProductsUnavailable(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := cartdb.NewMockCartRepository(ctrl)
+	products := productdb.NewMockProductRepository(ctrl)
+	holds := reservationdb.NewMockReservationRepository(ctrl)
+	s, _ := NewGetCartService(repo, products, holds, tracer)
+
+	repo.EXPECT().GetByUser(gomock.Any(), "u1").Return(domain.Cart{ID: "c1", UserID: "u1", Items: []domain.CartItem{{ProductID: "gone", Quantity: 1}, {ProductID: "p1", Quantity: 2}}}, nil)
+	holds.EXPECT().ListActiveByUser(gomock.Any(), "u1").Return(nil, nil)
+	products.EXPECT().GetByID(gomock.Any(), "gone").Return(productdomain.Product{}, gorm.ErrRecordNotFound)
+	products.EXPECT().GetByID(gomock.Any(), "p1").Return(productdomain.Product{ID: "p1", Name: "Mug", PriceCents: 1000, Available: 4}, nil)
+
+	cart, err := s.Get(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if !cart.Items[0].Unavailable || cart.Items[1].Unavailable {
+		t.Fatalf("unexpected availability: %+v", cart.Items)
+	}
+	if cart.TotalCents != 2000 {
+		t.Fatalf("unexpected total: %d", cart.TotalCents)
+	}
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/invoice/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbInvoiceRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (InvoiceRepository, error) {
+	return &dbInvoiceRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbInvoiceRepository) GetByOrder(ctx context.Context, orderID string) (domain.Invoice, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "InvoiceRepository.GetByOrder")
+	defer span.End()
+
+	var inv domain.Invoice
+	if err := appdb.Conn(ctx, r.db).Table("invoices").Where("order_id = ?", orderID).First(&inv).Error; err != nil {
+		span.RecordError(err)
+		return domain.Invoice{}, err
+	}
+	return inv, nil
+}
+
+func (r *dbInvoiceRepository) Issue(ctx context.Context, inv domain.Invoice) (domain.Invoice, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "InvoiceRepository.Issue")
+	defer span.End()
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// the counter row serializes issuers, so the check below cannot race
+		var last int64
+		if err := tx.Raw("SELECT last_number FROM invoice_numbers WHERE id FOR UPDATE").Scan(&last).Error; err != nil {
+			return err
+		}
+
+		var existing domain.Invoice
+		err := tx.Table("invoices").Where("order_id = ?", inv.OrderID).First(&existing).Error
+		if err == nil {
+			inv = existing
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		inv.ID = uuid.NewString()
+		inv.Number = last + 1
+		inv.IssuedAt = time.Now().UTC()
+		if err := tx.Table("invoices").Create(&inv).Error; err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE invoice_numbers SET last_number = ? WHERE id", inv.Number).Error
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Invoice{}, err
+	}
+	return inv, nil
+}
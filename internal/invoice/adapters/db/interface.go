@@ -0,0 +1,15 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/invoice/domain"
+)
+
+type InvoiceRepository interface {
+	GetByOrder(ctx context.Context, orderID string) (domain.Invoice, error)
+	// Issue stores inv with the next invoice number. When its order was
+	// invoiced meanwhile, the existing invoice is returned instead and no
+	// number is used.
+	Issue(ctx context.Context, inv domain.Invoice) (domain.Invoice, error)
+}
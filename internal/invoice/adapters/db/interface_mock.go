@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/invoice/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/invoice/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvoiceRepository is a mock of InvoiceRepository interface.
+type MockInvoiceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepositoryMockRecorder
+}
+
+// MockInvoiceRepositoryMockRecorder is the mock recorder for MockInvoiceRepository.
+type MockInvoiceRepositoryMockRecorder struct {
+	mock *MockInvoiceRepository
+}
+
+// NewMockInvoiceRepository creates a new mock instance.
+func NewMockInvoiceRepository(ctrl *gomock.Controller) *MockInvoiceRepository {
+	mock := &MockInvoiceRepository{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepository) EXPECT() *MockInvoiceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByOrder mocks base method.
+func (m *MockInvoiceRepository) GetByOrder(ctx context.Context, orderID string) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrder", ctx, orderID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrder indicates an expected call of GetByOrder.
+func (mr *MockInvoiceRepositoryMockRecorder) GetByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrder", reflect.TypeOf((*MockInvoiceRepository)(nil).GetByOrder), ctx, orderID)
+}
+
+// Issue mocks base method.
+func (m *MockInvoiceRepository) Issue(ctx context.Context, inv domain.Invoice) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, inv)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockInvoiceRepositoryMockRecorder) Issue(ctx, inv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockInvoiceRepository)(nil).Issue), ctx, inv)
+}
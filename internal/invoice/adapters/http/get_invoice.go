@@ -0,0 +1,84 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/invoice/domain"
+	"r2-challenge/internal/invoice/services/command"
+	"r2-challenge/internal/invoice/services/query"
+	orderqry "r2-challenge/internal/order/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type GetInvoiceHandler struct {
+	orders    orderqry.GetByIDService
+	issue     command.IssueInvoiceService
+	render    query.RenderInvoiceService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetInvoiceHandler(o orderqry.GetByIDService, is command.IssueInvoiceService, rs query.RenderInvoiceService, v *validator.Validate, t observability.Tracer) (GetInvoiceHandler, error) {
+	return GetInvoiceHandler{orders: o, issue: is, render: rs, validator: v, tracer: t}, nil
+}
+
+// Get Invoice
+// @Summary      Download order invoice
+// @Description  The invoice of an order as a PDF, issued with the next invoice number on first download (owner or admin)
+// @Tags         Orders
+// @Produce      application/pdf
+// @Param        id   path     string  true  "Order ID"
+// @Success      200  {file}   file
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      409  {object} map[string]string "Conflict"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/invoice [get]
+func (h GetInvoiceHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "InvoiceHTTP.Get")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	order, err := h.orders.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+
+	role, _ := c.Get(auth.CtxRole).(string)
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if role != "admin" && order.UserID != userID {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+	}
+
+	inv, err := h.issue.Issue(ctx, order)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, domain.ErrNotInvoiceable) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	doc, err := h.render.Render(ctx, inv, order)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", inv.Code()+".pdf"))
+	return c.Blob(http.StatusOK, "application/pdf", doc)
+}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"r2-challenge/internal/invoice/domain"
+	"r2-challenge/internal/invoice/services/command"
+	"r2-challenge/internal/invoice/services/query"
+	orderdomain "r2-challenge/internal/order/domain"
+	orderqry "r2-challenge/internal/order/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+	vsetup "r2-challenge/pkg/validator"
+)
+
+func newGetInvoiceContext(userID, role string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/o1/invoice", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("o1")
+	c.Set(auth.CtxUserID, userID)
+	c.Set(auth.CtxRole, role)
+	return c, rec
+}
+
+func TestGetInvoiceHandler_DownloadsForOwnerAndAdmin(t *testing.T) {
+	v, _ := vsetup.Setup()
+	tracer, _ := observability.SetupTracer()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	issue := command.NewMockIssueInvoiceService(ctrl)
+	render := query.NewMockRenderInvoiceService(ctrl)
+
+	handler, err := NewGetInvoiceHandler(orders, issue, render, v, tracer)
+	require.NoError(t, err)
+
+	order := orderdomain.Order{ID: "o1", UserID: "u1"}
+	inv := domain.Invoice{ID: "inv1", Number: 3, OrderID: "o1"}
+	for _, who := range [][2]string{{"u1", "customer"}, {"a1", "admin"}} {
+		orders.EXPECT().GetByID(gomock.Any(), "o1").Return(order, nil)
+		issue.EXPECT().Issue(gomock.Any(), order).Return(inv, nil)
+		render.EXPECT().Render(gomock.Any(), inv, order).Return([]byte("%PDF-1.4"), nil)
+
+		c, rec := newGetInvoiceContext(who[0], who[1])
+		require.NoError(t, handler.Handle(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, `attachment; filename="INV-000003.pdf"`, rec.Header().Get(echo.HeaderContentDisposition))
+		require.Equal(t, "%PDF-1.4", rec.Body.String())
+	}
+}
+
+func TestGetInvoiceHandler_Rejections(t *testing.T) {
+	v, _ := vsetup.Setup()
+	tracer, _ := observability.SetupTracer()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	issue := command.NewMockIssueInvoiceService(ctrl)
+	render := query.NewMockRenderInvoiceService(ctrl)
+
+	handler, err := NewGetInvoiceHandler(orders, issue, render, v, tracer)
+	require.NoError(t, err)
+
+	order := orderdomain.Order{ID: "o1", UserID: "u1", Status: orderdomain.StatusCancelled}
+
+	// another customer's order
+	orders.EXPECT().GetByID(gomock.Any(), "o1").Return(order, nil)
+	c, rec := newGetInvoiceContext("u2", "customer")
+	require.NoError(t, handler.Handle(c))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	// an order that was never sold
+	orders.EXPECT().GetByID(gomock.Any(), "o1").Return(order, nil)
+	issue.EXPECT().Issue(gomock.Any(), order).Return(domain.Invoice{}, domain.ErrNotInvoiceable)
+	c, rec = newGetInvoiceContext("u1", "customer")
+	require.NoError(t, handler.Handle(c))
+	require.Equal(t, http.StatusConflict, rec.Code)
+}
@@ -0,0 +1,13 @@
+package pdf
+
+import (
+	"r2-challenge/internal/invoice/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+)
+
+type Renderer interface {
+	// Render lays out the invoice of order as a PDF. productNames maps
+	// product ids to the names printed on the lines; lines of products
+	// missing from it show the product id.
+	Render(inv domain.Invoice, order orderdomain.Order, productNames map[string]string) ([]byte, error)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/invoice/adapters/pdf/interface.go
+
+// Package pdf is a generated GoMock package.
+package pdf
+
+import (
+	domain "r2-challenge/internal/invoice/domain"
+	domain0 "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRenderer is a mock of Renderer interface.
+type MockRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRendererMockRecorder
+}
+
+// MockRendererMockRecorder is the mock recorder for MockRenderer.
+type MockRendererMockRecorder struct {
+	mock *MockRenderer
+}
+
+// NewMockRenderer creates a new mock instance.
+func NewMockRenderer(ctrl *gomock.Controller) *MockRenderer {
+	mock := &MockRenderer{ctrl: ctrl}
+	mock.recorder = &MockRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenderer) EXPECT() *MockRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockRenderer) Render(inv domain.Invoice, order domain0.Order, productNames map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", inv, order, productNames)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockRendererMockRecorder) Render(inv, order, productNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockRenderer)(nil).Render), inv, order, productNames)
+}
@@ -0,0 +1,215 @@
+package pdf
+
+import (
+	"fmt"
+	"strings"
+
+	"r2-challenge/cmd/envs"
+	"r2-challenge/internal/invoice/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+	"r2-challenge/pkg/pdf"
+)
+
+const (
+	margin     = 50.0
+	right      = pdf.PageWidth - margin
+	lineHeight = 14.0
+	tableSize  = 9.0
+	// a new page starts when a line would go below this height
+	bottom = 110.0
+)
+
+// columns of the item table, by the x of their right edge; the description
+// is left-aligned at the margin
+var columns = []struct {
+	title string
+	right float64
+}{
+	{"Qty", 290},
+	{"Unit price", 350},
+	{"Discount", 410},
+	{"Tax rate", 455},
+	{"Tax", 500},
+	{"Total", right},
+}
+
+// seller is who issues the invoices, printed at the top of every invoice.
+type seller struct {
+	name    string
+	address []string
+	taxID   string
+}
+
+type renderer struct {
+	seller seller
+}
+
+// NewRenderer prints INVOICE_SELLER_NAME, the ";"-separated lines of
+// INVOICE_SELLER_ADDRESS and INVOICE_SELLER_TAX_ID as the seller.
+func NewRenderer(e envs.Envs) (Renderer, error) {
+	s := seller{name: strings.TrimSpace(e.InvoiceSellerName), taxID: strings.TrimSpace(e.InvoiceSellerTaxID)}
+	if s.name == "" {
+		s.name = "R2 Challenge"
+	}
+	for _, line := range strings.Split(e.InvoiceSellerAddress, ";") {
+		if line = strings.TrimSpace(line); line != "" {
+			s.address = append(s.address, line)
+		}
+	}
+	return &renderer{seller: s}, nil
+}
+
+func (r *renderer) Render(inv domain.Invoice, order orderdomain.Order, productNames map[string]string) ([]byte, error) {
+	if inv.OrderID != order.ID {
+		return nil, fmt.Errorf("invoice %s is not for order %s", inv.Code(), order.ID)
+	}
+
+	doc := pdf.New("Invoice " + inv.Code())
+	page := doc.AddPage()
+	y := r.header(page, inv, order)
+	y = tableHeader(page, y)
+
+	for _, it := range order.Items {
+		if y < bottom {
+			page = doc.AddPage()
+			page.Text(margin, pdf.PageHeight-margin, pdf.Bold, 10, inv.Code()+" (continued)")
+			y = tableHeader(page, pdf.PageHeight-margin-2*lineHeight)
+		}
+		name := productNames[it.ProductID]
+		if name == "" {
+			name = it.ProductID
+		}
+		page.Text(margin, y, pdf.Regular, tableSize, fit(name, columns[0].right-margin-40))
+		for i, v := range []string{
+			fmt.Sprintf("%d", it.Quantity),
+			money(it.PriceCents),
+			money(it.DiscountCents),
+			percent(it.TaxRateBps),
+			money(it.TaxCents),
+			money(it.TotalCents),
+		} {
+			page.TextRight(columns[i].right, y, pdf.Regular, tableSize, v)
+		}
+		y -= lineHeight
+	}
+
+	page.Line(margin, y+lineHeight-4, right, y+lineHeight-4)
+	if y-6*lineHeight < bottom-2*lineHeight {
+		page = doc.AddPage()
+		y = pdf.PageHeight - margin
+	}
+	y -= lineHeight / 2
+	shipping := "Shipping"
+	if order.ShippingMethod != "" {
+		shipping += " (" + order.ShippingMethod + ")"
+	}
+	for _, t := range []struct {
+		label string
+		cents int64
+	}{
+		{"Subtotal", inv.SubtotalCents},
+		{"Discount", -inv.DiscountCents},
+		{"Tax", inv.TaxCents},
+		{shipping, inv.ShippingCents},
+	} {
+		page.TextRight(450, y, pdf.Regular, 10, t.label)
+		page.TextRight(right, y, pdf.Regular, 10, money(t.cents))
+		y -= lineHeight
+	}
+	page.TextRight(450, y-2, pdf.Bold, 12, "Total")
+	page.TextRight(right, y-2, pdf.Bold, 12, money(inv.TotalCents))
+
+	page.Text(margin, margin, pdf.Regular, 8, "Thank you for your order.")
+	return doc.Bytes(), nil
+}
+
+// header draws the seller, the invoice details and the customer, and
+// returns the height where the item table starts.
+func (r *renderer) header(page *pdf.Page, inv domain.Invoice, order orderdomain.Order) float64 {
+	top := pdf.PageHeight - margin
+	page.Text(margin, top-4, pdf.Bold, 16, r.seller.name)
+	y := top - 4 - lineHeight - 4
+	for _, line := range r.seller.address {
+		page.Text(margin, y, pdf.Regular, 9, line)
+		y -= 12
+	}
+	if r.seller.taxID != "" {
+		page.Text(margin, y, pdf.Regular, 9, "Tax ID: "+r.seller.taxID)
+		y -= 12
+	}
+
+	page.TextRight(right, top-4, pdf.Bold, 20, "INVOICE")
+	details := []string{
+		"Invoice no. " + inv.Code(),
+		"Issued " + inv.IssuedAt.Format("2006-01-02"),
+		"Order " + order.ID,
+		"Order date " + order.CreatedAt.Format("2006-01-02"),
+	}
+	dy := top - 4 - lineHeight - 8
+	for _, d := range details {
+		page.TextRight(right, dy, pdf.Regular, 9, d)
+		dy -= 12
+	}
+	if dy < y {
+		y = dy
+	}
+
+	y -= lineHeight
+	page.Text(margin, y, pdf.Bold, 10, "Bill to")
+	y -= lineHeight
+	for _, line := range billTo(order) {
+		page.Text(margin, y, pdf.Regular, 9, line)
+		y -= 12
+	}
+	return y - lineHeight
+}
+
+func tableHeader(page *pdf.Page, y float64) float64 {
+	page.Text(margin, y, pdf.Bold, tableSize, "Description")
+	for _, c := range columns {
+		page.TextRight(c.right, y, pdf.Bold, tableSize, c.title)
+	}
+	page.Line(margin, y-4, right, y-4)
+	return y - lineHeight - 2
+}
+
+// billTo is the customer block: the shipping address copied onto the order.
+func billTo(order orderdomain.Order) []string {
+	a := order.ShippingAddress
+	if a == nil {
+		return []string{"Customer " + order.UserID}
+	}
+	lines := []string{a.Name, a.Line1}
+	if a.Line2 != "" {
+		lines = append(lines, a.Line2)
+	}
+	city := strings.TrimSpace(strings.Join([]string{a.City, a.Region, a.PostalCode}, " "))
+	return append(lines, city, a.Country)
+}
+
+// fit shortens s with an ellipsis until it is at most width points wide.
+func fit(s string, width float64) string {
+	if pdf.Width(pdf.Regular, tableSize, s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && pdf.Width(pdf.Regular, tableSize, string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+func money(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
+
+// percent renders a rate in basis points, e.g. 725 as "7.25%".
+func percent(bps int64) string {
+	s := fmt.Sprintf("%d.%02d", bps/100, bps%100)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return s + "%"
+}
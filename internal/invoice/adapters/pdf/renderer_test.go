@@ -0,0 +1,59 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"r2-challenge/cmd/envs"
+	"r2-challenge/internal/invoice/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	r, _ := NewRenderer(envs.Envs{InvoiceSellerName: "Acme", InvoiceSellerAddress: "1 Main St; Springfield", InvoiceSellerTaxID: "12-345"})
+	order := orderdomain.Order{ID: "o1", UserID: "u1", CreatedAt: time.Now(), Items: []orderdomain.OrderItem{
+		{ProductID: "p1", Quantity: 2, PriceCents: 500, TaxRateBps: 725, TaxCents: 73, TotalCents: 1073},
+		{ProductID: "p2", Quantity: 1, PriceCents: 250, TotalCents: 250},
+	}}
+	inv := domain.Invoice{Number: 42, OrderID: "o1", SubtotalCents: 1250, TaxCents: 73, TotalCents: 1323, IssuedAt: time.Now()}
+
+	doc, err := r.Render(inv, order, map[string]string{"p1": "Widget (large)"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !bytes.HasPrefix(doc, []byte("%PDF-1.4")) || !bytes.HasSuffix(doc, []byte("%%EOF\n")) {
+		t.Fatalf("not a PDF document")
+	}
+	for _, want := range []string{"INV-000042", "Acme", "Springfield", "Tax ID: 12-345", `Widget \(large\)`, "p2", "7.25%", "$13.23"} {
+		if !bytes.Contains(doc, []byte(want)) {
+			t.Fatalf("expected %q in the document", want)
+		}
+	}
+
+	if _, err := r.Render(domain.Invoice{OrderID: "o2"}, order, nil); err == nil {
+		t.Fatalf("expected an error for an invoice of another order")
+	}
+}
+
+func TestRenderer_Render_ContinuesOnNewPages(t *testing.T) {
+	r, _ := NewRenderer(envs.Envs{})
+	order := orderdomain.Order{ID: "o1"}
+	for i := 0; i < 120; i++ {
+		order.Items = append(order.Items, orderdomain.OrderItem{ProductID: fmt.Sprintf("p%d", i), Quantity: 1, PriceCents: 100, TotalCents: 100})
+	}
+
+	doc, err := r.Render(domain.Invoice{Number: 1, OrderID: "o1"}, order, nil)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	pages := strings.Count(string(doc), "/Type /Page ")
+	if pages < 3 || !bytes.Contains(doc, []byte("INV-000001 \\(continued\\)")) {
+		t.Fatalf("expected the items to continue over several pages, got %d pages", pages)
+	}
+	if !bytes.Contains(doc, []byte("p119")) || !bytes.Contains(doc, []byte("R2 Challenge")) {
+		t.Fatalf("expected every item and the default seller")
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	orderdomain "r2-challenge/internal/order/domain"
+)
+
+// ErrNotInvoiceable is returned when an order that was never sold, i.e.
+// cancelled or whose payment failed, has no invoice yet.
+var ErrNotInvoiceable = errors.New("order cannot be invoiced")
+
+// Invoice is the invoice of one order. Numbers increase by one in the order
+// invoices are issued, without gaps. The amounts are copied from the order
+// when the invoice is issued; the lines are the order's items.
+type Invoice struct {
+	ID            string    `json:"id" gorm:"primaryKey;type:uuid"`
+	Number        int64     `json:"number"`
+	OrderID       string    `json:"order_id" gorm:"type:uuid"`
+	UserID        string    `json:"user_id" gorm:"type:uuid"`
+	SubtotalCents int64     `json:"subtotal_cents"`
+	DiscountCents int64     `json:"discount_cents"`
+	TaxCents      int64     `json:"tax_cents"`
+	ShippingCents int64     `json:"shipping_cents"`
+	TotalCents    int64     `json:"total_cents"`
+	IssuedAt      time.Time `json:"issued_at"`
+}
+
+// Code is the invoice number as printed, e.g. INV-000042.
+func (i Invoice) Code() string {
+	return fmt.Sprintf("INV-%06d", i.Number)
+}
+
+// For returns the invoice to issue for order, without a number yet.
+func For(order orderdomain.Order) (Invoice, error) {
+	if order.Status == orderdomain.StatusCancelled || order.Status == orderdomain.StatusPaymentFailed {
+		return Invoice{}, fmt.Errorf("%w: order is %s", ErrNotInvoiceable, order.Status)
+	}
+	return Invoice{
+		OrderID:       order.ID,
+		UserID:        order.UserID,
+		SubtotalCents: order.SubtotalCents,
+		DiscountCents: order.DiscountCents,
+		TaxCents:      order.TaxCents,
+		ShippingCents: order.ShippingCents,
+		TotalCents:    order.TotalCents,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	repo "r2-challenge/internal/invoice/adapters/db"
+	"r2-challenge/internal/invoice/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type IssueInvoiceService interface {
+	// Issue returns the invoice of the order, issuing it with the next number
+	// on first use. Orders without an invoice that were cancelled or whose
+	// payment failed fail with domain.ErrNotInvoiceable.
+	Issue(ctx context.Context, order orderdomain.Order) (domain.Invoice, error)
+}
+
+type issueInvoiceService struct {
+	repo   repo.InvoiceRepository
+	tracer observability.Tracer
+}
+
+func NewIssueInvoiceService(r repo.InvoiceRepository, t observability.Tracer) (IssueInvoiceService, error) {
+	return &issueInvoiceService{repo: r, tracer: t}, nil
+}
+
+func (s *issueInvoiceService) Issue(ctx context.Context, order orderdomain.Order) (domain.Invoice, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "InvoiceCommand.Issue")
+	defer span.End()
+
+	// an invoice outlives the order's later cancellation or refund
+	existing, err := s.repo.GetByOrder(ctx, order.ID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		return domain.Invoice{}, err
+	}
+
+	inv, err := domain.For(order)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Invoice{}, err
+	}
+
+	issued, err := s.repo.Issue(ctx, inv)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Invoice{}, err
+	}
+
+	return issued, nil
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/invoice/services/command/issue_invoice.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/invoice/domain"
+	domain0 "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIssueInvoiceService is a mock of IssueInvoiceService interface.
+type MockIssueInvoiceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueInvoiceServiceMockRecorder
+}
+
+// MockIssueInvoiceServiceMockRecorder is the mock recorder for MockIssueInvoiceService.
+type MockIssueInvoiceServiceMockRecorder struct {
+	mock *MockIssueInvoiceService
+}
+
+// NewMockIssueInvoiceService creates a new mock instance.
+func NewMockIssueInvoiceService(ctrl *gomock.Controller) *MockIssueInvoiceService {
+	mock := &MockIssueInvoiceService{ctrl: ctrl}
+	mock.recorder = &MockIssueInvoiceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueInvoiceService) EXPECT() *MockIssueInvoiceServiceMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockIssueInvoiceService) Issue(ctx context.Context, order domain0.Order) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, order)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockIssueInvoiceServiceMockRecorder) Issue(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockIssueInvoiceService)(nil).Issue), ctx, order)
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+
+	repo "r2-challenge/internal/invoice/adapters/db"
+	"r2-challenge/internal/invoice/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestIssueInvoice_IssuesOnce(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockInvoiceRepository(ctrl)
+	s, _ := NewIssueInvoiceService(r, tracer)
+	order := orderdomain.Order{ID: "o1", UserID: "u1", Status: orderdomain.StatusCreated, SubtotalCents: 1000, TaxCents: 80, TotalCents: 1080}
+
+	r.EXPECT().GetByOrder(gomock.Any(), "o1").Return(domain.Invoice{}, gorm.ErrRecordNotFound)
+	r.EXPECT().Issue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, inv domain.Invoice) (domain.Invoice, error) {
+		if inv.OrderID != "o1" || inv.UserID != "u1" || inv.TotalCents != 1080 || inv.TaxCents != 80 {
+			t.Fatalf("unexpected invoice: %+v", inv)
+		}
+		inv.ID, inv.Number = "inv1", 7
+		return inv, nil
+	})
+	inv, err := s.Issue(context.Background(), order)
+	if err != nil || inv.Code() != "INV-000007" {
+		t.Fatalf("unexpected result: %+v, %v", inv, err)
+	}
+
+	// the existing invoice is returned, even after the order was cancelled
+	order.Status = orderdomain.StatusCancelled
+	r.EXPECT().GetByOrder(gomock.Any(), "o1").Return(inv, nil)
+	again, err := s.Issue(context.Background(), order)
+	if err != nil || again.Number != 7 {
+		t.Fatalf("unexpected result: %+v, %v", again, err)
+	}
+}
+
+func TestIssueInvoice_RejectsUnsoldOrders(t *testing.T) {
+	for _, status := range []string{orderdomain.StatusCancelled, orderdomain.StatusPaymentFailed} {
+		t.Run(status, func(t *testing.T) {
+			tracer, _ := observability.SetupTracer()
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			r := repo.NewMockInvoiceRepository(ctrl)
+			s, _ := NewIssueInvoiceService(r, tracer)
+
+			r.EXPECT().GetByOrder(gomock.Any(), "o1").Return(domain.Invoice{}, gorm.ErrRecordNotFound)
+			_, err := s.Issue(context.Background(), orderdomain.Order{ID: "o1", Status: status})
+			if !errors.Is(err, domain.ErrNotInvoiceable) {
+				t.Fatalf("expected ErrNotInvoiceable, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/invoice/adapters/pdf"
+	"r2-challenge/internal/invoice/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+	productqry "r2-challenge/internal/product/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type RenderInvoiceService interface {
+	// Render returns the invoice of order as a PDF, its lines named after
+	// the products in the catalog.
+	Render(ctx context.Context, inv domain.Invoice, order orderdomain.Order) ([]byte, error)
+}
+
+type renderInvoiceService struct {
+	products productqry.GetByIDService
+	renderer pdf.Renderer
+	tracer   observability.Tracer
+}
+
+func NewRenderInvoiceService(p productqry.GetByIDService, r pdf.Renderer, t observability.Tracer) (RenderInvoiceService, error) {
+	return &renderInvoiceService{products: p, renderer: r, tracer: t}, nil
+}
+
+func (s *renderInvoiceService) Render(ctx context.Context, inv domain.Invoice, order orderdomain.Order) ([]byte, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "InvoiceQuery.Render")
+	defer span.End()
+
+	names := make(map[string]string, len(order.Items))
+	for _, it := range order.Items {
+		if _, ok := names[it.ProductID]; ok {
+			continue
+		}
+		p, err := s.products.GetByID(ctx, it.ProductID)
+		// a product removed from the catalog is printed by id
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		names[it.ProductID] = p.Name
+	}
+
+	doc, err := s.renderer.Render(inv, order, names)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/invoice/services/query/render_invoice.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/invoice/domain"
+	domain0 "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRenderInvoiceService is a mock of RenderInvoiceService interface.
+type MockRenderInvoiceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRenderInvoiceServiceMockRecorder
+}
+
+// MockRenderInvoiceServiceMockRecorder is the mock recorder for MockRenderInvoiceService.
+type MockRenderInvoiceServiceMockRecorder struct {
+	mock *MockRenderInvoiceService
+}
+
+// NewMockRenderInvoiceService creates a new mock instance.
+func NewMockRenderInvoiceService(ctrl *gomock.Controller) *MockRenderInvoiceService {
+	mock := &MockRenderInvoiceService{ctrl: ctrl}
+	mock.recorder = &MockRenderInvoiceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenderInvoiceService) EXPECT() *MockRenderInvoiceServiceMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockRenderInvoiceService) Render(ctx context.Context, inv domain.Invoice, order domain0.Order) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, inv, order)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockRenderInvoiceServiceMockRecorder) Render(ctx, inv, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockRenderInvoiceService)(nil).Render), ctx, inv, order)
+}
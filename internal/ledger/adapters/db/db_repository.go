@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/ledger/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbLedgerRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (LedgerRepository, error) {
+	return &dbLedgerRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbLedgerRepository) Post(ctx context.Context, entry domain.JournalEntry) (bool, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "LedgerRepository.Post")
+	defer span.End()
+
+	if err := entry.Validate(); err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+
+	now := time.Now().UTC()
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+
+	posted := false
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(
+			`INSERT INTO ledger_entries (id, idempotency_key, order_id, description, created_at)
+			 VALUES (?, ?, ?, ?, ?)
+			 ON CONFLICT (idempotency_key) DO NOTHING`,
+			entry.ID, entry.Key, entry.OrderID, entry.Description, now,
+		)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		postings := make([]domain.Posting, 0, len(entry.Postings))
+		for _, p := range entry.Postings {
+			p.ID = uuid.NewString()
+			p.EntryID = entry.ID
+			p.CreatedAt = now
+			postings = append(postings, p)
+		}
+		if err := tx.Table("ledger_postings").Create(&postings).Error; err != nil {
+			return err
+		}
+		posted = true
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+
+	return posted, nil
+}
+
+const balancesQuery = `SELECT a.code, a.name, a.type, a.created_at,
+	COALESCE(SUM(p.amount_cents) FILTER (WHERE p.amount_cents > 0), 0) AS debit_cents,
+	COALESCE(-SUM(p.amount_cents) FILTER (WHERE p.amount_cents < 0), 0) AS credit_cents,
+	COALESCE(SUM(p.amount_cents), 0) AS balance_cents
+	FROM ledger_accounts a
+	LEFT JOIN ledger_postings p ON p.account_code = a.code`
+
+func (r *dbLedgerRepository) Balances(ctx context.Context) ([]domain.Balance, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "LedgerRepository.Balances")
+	defer span.End()
+
+	var balances []domain.Balance
+	if err := appdb.Conn(ctx, r.db).Raw(balancesQuery + ` GROUP BY a.code ORDER BY a.code`).Scan(&balances).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+func (r *dbLedgerRepository) Balance(ctx context.Context, accountCode string) (domain.Balance, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "LedgerRepository.Balance")
+	defer span.End()
+
+	var balances []domain.Balance
+	if err := appdb.Conn(ctx, r.db).Raw(balancesQuery+` WHERE a.code = ? GROUP BY a.code`, accountCode).Scan(&balances).Error; err != nil {
+		span.RecordError(err)
+		return domain.Balance{}, err
+	}
+	if len(balances) == 0 {
+		return domain.Balance{}, gorm.ErrRecordNotFound
+	}
+
+	return balances[0], nil
+}
+
+func (r *dbLedgerRepository) ListEntries(ctx context.Context, filter EntryFilter) ([]domain.JournalEntry, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "LedgerRepository.ListEntries")
+	defer span.End()
+
+	db := appdb.Conn(ctx, r.db)
+	q := db.Table("ledger_entries").Order("created_at DESC, id")
+	if filter.OrderID != "" {
+		q = q.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.AccountCode != "" {
+		q = q.Where("id IN (SELECT entry_id FROM ledger_postings WHERE account_code = ?)", filter.AccountCode)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		q = q.Offset(filter.Offset)
+	}
+
+	var entries []domain.JournalEntry
+	if err := q.Find(&entries).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	var postings []domain.Posting
+	if err := db.Table("ledger_postings").Where("entry_id IN ?", ids).Order("created_at, id").Find(&postings).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	byEntry := make(map[string][]domain.Posting, len(entries))
+	for _, p := range postings {
+		byEntry[p.EntryID] = append(byEntry[p.EntryID], p)
+	}
+	for i := range entries {
+		entries[i].Postings = byEntry[entries[i].ID]
+	}
+
+	return entries, nil
+}
+
+func (r *dbLedgerRepository) Check(ctx context.Context) (domain.Check, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "LedgerRepository.Check")
+	defer span.End()
+
+	db := appdb.Conn(ctx, r.db)
+
+	var check domain.Check
+	if err := db.Raw(
+		`SELECT (SELECT COUNT(*) FROM ledger_entries) AS entries,
+		 (SELECT COALESCE(SUM(amount_cents), 0) FROM ledger_postings) AS total_cents`,
+	).Scan(&check).Error; err != nil {
+		span.RecordError(err)
+		return domain.Check{}, err
+	}
+
+	check.Unbalanced = []string{}
+	if err := db.Raw(
+		`SELECT e.id FROM ledger_entries e
+		 LEFT JOIN ledger_postings p ON p.entry_id = e.id
+		 GROUP BY e.id
+		 HAVING COALESCE(SUM(p.amount_cents), 0) <> 0 OR COUNT(p.id) < 2
+		 ORDER BY e.id`,
+	).Scan(&check.Unbalanced).Error; err != nil {
+		span.RecordError(err)
+		return domain.Check{}, err
+	}
+	check.Balanced = check.TotalCents == 0 && len(check.Unbalanced) == 0
+
+	return check, nil
+}
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/ledger/domain"
+)
+
+type EntryFilter struct {
+	AccountCode string
+	OrderID     string
+	Limit       int
+	Offset      int
+}
+
+type LedgerRepository interface {
+	// Post writes the entry and its postings in one transaction. It reports
+	// false, writing nothing, when an entry with the same key was already
+	// posted.
+	Post(ctx context.Context, entry domain.JournalEntry) (bool, error)
+	// Balances returns every account with the sum of its postings.
+	Balances(ctx context.Context) ([]domain.Balance, error)
+	// Balance returns gorm.ErrRecordNotFound for an unknown account.
+	Balance(ctx context.Context, accountCode string) (domain.Balance, error)
+	// ListEntries returns entries with their postings, newest first.
+	ListEntries(ctx context.Context, filter EntryFilter) ([]domain.JournalEntry, error)
+	// Check verifies that every entry, and so the whole ledger, balances.
+	Check(ctx context.Context) (domain.Check, error)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ledger/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/ledger/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Balance mocks base method.
+func (m *MockLedgerRepository) Balance(ctx context.Context, accountCode string) (domain.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balance", ctx, accountCode)
+	ret0, _ := ret[0].(domain.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balance indicates an expected call of Balance.
+func (mr *MockLedgerRepositoryMockRecorder) Balance(ctx, accountCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockLedgerRepository)(nil).Balance), ctx, accountCode)
+}
+
+// Balances mocks base method.
+func (m *MockLedgerRepository) Balances(ctx context.Context) ([]domain.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balances", ctx)
+	ret0, _ := ret[0].([]domain.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balances indicates an expected call of Balances.
+func (mr *MockLedgerRepositoryMockRecorder) Balances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balances", reflect.TypeOf((*MockLedgerRepository)(nil).Balances), ctx)
+}
+
+// Check mocks base method.
+func (m *MockLedgerRepository) Check(ctx context.Context) (domain.Check, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(domain.Check)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockLedgerRepositoryMockRecorder) Check(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockLedgerRepository)(nil).Check), ctx)
+}
+
+// ListEntries mocks base method.
+func (m *MockLedgerRepository) ListEntries(ctx context.Context, filter EntryFilter) ([]domain.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, filter)
+	ret0, _ := ret[0].([]domain.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockLedgerRepositoryMockRecorder) ListEntries(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockLedgerRepository)(nil).ListEntries), ctx, filter)
+}
+
+// Post mocks base method.
+func (m *MockLedgerRepository) Post(ctx context.Context, entry domain.JournalEntry) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, entry)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockLedgerRepositoryMockRecorder) Post(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockLedgerRepository)(nil).Post), ctx, entry)
+}
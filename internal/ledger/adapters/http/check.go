@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/ledger/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type CheckHandler struct {
+	service   query.CheckService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCheckHandler(s query.CheckService, v *validator.Validate, t observability.Tracer) (CheckHandler, error) {
+	return CheckHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Check Ledger
+// @Summary      Check ledger invariant
+// @Description  Verify that every journal entry balances to zero (admin only)
+// @Tags         Ledger
+// @Produce      json
+// @Success      200  {object} domain.Check
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /ledger/check [get]
+func (h CheckHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "LedgerHTTP.Check")
+	defer span.End()
+
+	check, err := h.service.Check(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, check)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/ledger/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type GetAccountHandler struct {
+	service   query.BalancesService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetAccountHandler(s query.BalancesService, v *validator.Validate, t observability.Tracer) (GetAccountHandler, error) {
+	return GetAccountHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Ledger Account
+// @Summary      Get ledger account balance
+// @Description  Debit, credit and balance totals of one account (admin only)
+// @Tags         Ledger
+// @Produce      json
+// @Param        code  path     string  true  "Account code"
+// @Success      200   {object} domain.Balance
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /ledger/accounts/{code} [get]
+func (h GetAccountHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "LedgerHTTP.GetAccount")
+	defer span.End()
+
+	balance, err := h.service.Balance(ctx, c.Param("code"))
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, balance)
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/ledger/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListAccountsHandler struct {
+	service   query.BalancesService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListAccountsHandler(s query.BalancesService, v *validator.Validate, t observability.Tracer) (ListAccountsHandler, error) {
+	return ListAccountsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Ledger Accounts
+// @Summary      List ledger accounts
+// @Description  Every ledger account with its debit, credit and balance totals (admin only)
+// @Tags         Ledger
+// @Produce      json
+// @Success      200  {array}  domain.Balance
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /ledger/accounts [get]
+func (h ListAccountsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "LedgerHTTP.ListAccounts")
+	defer span.End()
+
+	balances, err := h.service.Balances(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, balances)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	repo "r2-challenge/internal/ledger/adapters/db"
+	"r2-challenge/internal/ledger/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListEntriesHandler struct {
+	service   query.ListEntriesService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListEntriesHandler(s query.ListEntriesService, v *validator.Validate, t observability.Tracer) (ListEntriesHandler, error) {
+	return ListEntriesHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Journal Entries
+// @Summary      List journal entries
+// @Description  Journal entries with their postings, newest first (admin only)
+// @Tags         Ledger
+// @Produce      json
+// @Param        account   query    string  false  "Only entries posting to this account"
+// @Param        order_id  query    string  false  "Only entries of this order"
+// @Param        limit     query    int     false  "Limit"
+// @Param        offset    query    int     false  "Offset"
+// @Success      200       {array}  domain.JournalEntry
+// @Failure      400       {object} map[string]string "Bad Request"
+// @Failure      401       {object} map[string]string "Unauthorized"
+// @Failure      403       {object} map[string]string "Forbidden"
+// @Failure      500       {object} map[string]string "Internal Server Error"
+// @Router       /ledger/entries [get]
+func (h ListEntriesHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "LedgerHTTP.ListEntries")
+	defer span.End()
+
+	filter := repo.EntryFilter{AccountCode: c.QueryParam("account"), OrderID: c.QueryParam("order_id"), Limit: 50}
+	if err := h.validator.Var(filter.OrderID, "omitempty,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid order_id"})
+	}
+	if s := c.QueryParam("limit"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			filter.Limit = v
+		}
+	}
+	if s := c.QueryParam("offset"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			filter.Offset = v
+		}
+	}
+
+	entries, err := h.service.List(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
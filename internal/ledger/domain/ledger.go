@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Account types.
+const (
+	AccountTypeAsset         = "asset"
+	AccountTypeLiability     = "liability"
+	AccountTypeRevenue       = "revenue"
+	AccountTypeContraRevenue = "contra_revenue"
+)
+
+// Chart of accounts, seeded by the ledger migration.
+const (
+	// AccountReceivable holds what customers owe for placed orders.
+	AccountReceivable = "receivable"
+	// AccountProcessorClearing holds money collected by the payment processor.
+	AccountProcessorClearing = "processor_clearing"
+	// AccountTaxPayable holds the tax collected on orders and owed to the authorities.
+	AccountTaxPayable = "tax_payable"
+	AccountSales      = "sales"
+	AccountRefunds    = "refunds"
+)
+
+var (
+	// ErrUnbalanced is returned when the postings of an entry do not sum to zero.
+	ErrUnbalanced = errors.New("journal entry does not balance")
+	// ErrTooFewPostings is returned when an entry moves money between fewer than two postings.
+	ErrTooFewPostings = errors.New("journal entry needs at least two postings")
+	ErrZeroPosting    = errors.New("posting amount must not be zero")
+	ErrMissingKey     = errors.New("journal entry needs a key")
+)
+
+type Account struct {
+	Code      string    `json:"code" gorm:"primaryKey"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// JournalEntry is one balanced money movement. Key identifies the business
+// event that caused it, so the same event is never posted twice.
+type JournalEntry struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid"`
+	Key         string    `json:"key" gorm:"column:idempotency_key"`
+	OrderID     *string   `json:"order_id,omitempty" gorm:"type:uuid"`
+	Description string    `json:"description"`
+	Postings    []Posting `json:"postings" gorm:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Posting moves AmountCents on one account: debits are positive, credits
+// negative.
+type Posting struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid"`
+	EntryID     string    `json:"entry_id" gorm:"type:uuid"`
+	AccountCode string    `json:"account_code"`
+	AmountCents int64     `json:"amount_cents"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Balance sums the postings of an account. BalanceCents is debits minus
+// credits, so revenue accounts carry a negative balance.
+type Balance struct {
+	Account
+	DebitCents   int64 `json:"debit_cents"`
+	CreditCents  int64 `json:"credit_cents"`
+	BalanceCents int64 `json:"balance_cents"`
+}
+
+// Check is the result of verifying that the ledger balances.
+type Check struct {
+	Balanced bool  `json:"balanced"`
+	Entries  int64 `json:"entries"`
+	// TotalCents sums every posting; it is zero when the ledger balances.
+	TotalCents int64 `json:"total_cents"`
+	// Unbalanced lists entries whose postings do not sum to zero or that
+	// have fewer than two postings.
+	Unbalanced []string `json:"unbalanced"`
+}
+
+// Validate checks the double-entry invariant: at least two non-zero
+// postings that sum to zero.
+func (e JournalEntry) Validate() error {
+	if e.Key == "" {
+		return ErrMissingKey
+	}
+	if len(e.Postings) < 2 {
+		return ErrTooFewPostings
+	}
+	var sum int64
+	for _, p := range e.Postings {
+		if p.AmountCents == 0 {
+			return fmt.Errorf("%w: account %s", ErrZeroPosting, p.AccountCode)
+		}
+		sum += p.AmountCents
+	}
+	if sum != 0 {
+		return fmt.Errorf("%w: off by %d", ErrUnbalanced, sum)
+	}
+	return nil
+}
+
+// NewEntry builds an entry from postings, leaving out zero amounts. An
+// empty orderID leaves the entry without an order.
+func NewEntry(key string, orderID string, description string, postings ...Posting) JournalEntry {
+	e := JournalEntry{Key: key, Description: description}
+	for _, p := range postings {
+		if p.AmountCents != 0 {
+			e.Postings = append(e.Postings, p)
+		}
+	}
+	if orderID != "" {
+		e.OrderID = &orderID
+	}
+	return e
+}
+
+// Transfer builds an entry debiting one account and crediting another by
+// amountCents. An empty orderID leaves the entry without an order.
+func Transfer(key string, orderID string, description string, debit string, credit string, amountCents int64) JournalEntry {
+	e := JournalEntry{
+		Key:         key,
+		Description: description,
+		Postings: []Posting{
+			{AccountCode: debit, AmountCents: amountCents},
+			{AccountCode: credit, AmountCents: -amountCents},
+		},
+	}
+	if orderID != "" {
+		e.OrderID = &orderID
+	}
+	return e
+}
@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+
+	repo "r2-challenge/internal/ledger/adapters/db"
+	"r2-challenge/internal/ledger/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+	orderqry "r2-challenge/internal/order/services/query"
+	outboxdomain "r2-challenge/internal/outbox/domain"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type RecordMovementService interface {
+	// Record posts the journal entry for a money-moving outbox event. It is
+	// subscribed to the outbox, so it runs inside the dispatcher transaction;
+	// redelivered events are posted once.
+	Record(ctx context.Context, event outboxdomain.Event) error
+}
+
+type recordMovementService struct {
+	repo   repo.LedgerRepository
+	orders orderqry.GetByIDService
+	tracer observability.Tracer
+}
+
+func NewRecordMovementService(r repo.LedgerRepository, o orderqry.GetByIDService, t observability.Tracer) (RecordMovementService, error) {
+	return &recordMovementService{repo: r, orders: o, tracer: t}, nil
+}
+
+func (s *recordMovementService) Record(ctx context.Context, event outboxdomain.Event) error {
+	ctx, span := s.tracer.StartSpan(ctx, "LedgerCommand.Record")
+	defer span.End()
+
+	entry, ok, err := s.entryFor(ctx, event)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	if _, err := s.repo.Post(ctx, entry); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// entryFor maps an event to its journal entry; ok is false for events that
+// move no money.
+func (s *recordMovementService) entryFor(ctx context.Context, event outboxdomain.Event) (domain.JournalEntry, bool, error) {
+	switch event.Topic {
+	case orderdomain.TopicOrderPlaced:
+		var o orderdomain.Order
+		if err := json.Unmarshal(event.Payload, &o); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		tax := taxShare(o, o.TotalCents)
+		return domain.NewEntry("order.placed:"+o.ID, o.ID, "order placed",
+			domain.Posting{AccountCode: domain.AccountReceivable, AmountCents: o.TotalCents},
+			domain.Posting{AccountCode: domain.AccountSales, AmountCents: -(o.TotalCents - tax)},
+			domain.Posting{AccountCode: domain.AccountTaxPayable, AmountCents: -tax},
+		), o.TotalCents > 0, nil
+
+	case orderdomain.TopicOrderStatusChanged:
+		var c orderdomain.StatusChanged
+		if err := json.Unmarshal(event.Payload, &c); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		// the charge at checkout failed, so the sale never happened
+		if c.To != orderdomain.StatusPaymentFailed {
+			return domain.JournalEntry{}, false, nil
+		}
+		o, err := s.orders.GetByID(ctx, c.OrderID)
+		if err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		return reversal("order.payment_failed:"+o.ID, o, "sale reversed: payment failed", o.TotalCents), o.TotalCents > 0, nil
+
+	case orderdomain.TopicOrderExpired:
+		var o orderdomain.Order
+		if err := json.Unmarshal(event.Payload, &o); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		// nothing was ever collected for an expired order
+		return reversal("order.expired:"+o.ID, o, "sale reversed: order expired", o.TotalCents), o.TotalCents > 0, nil
+
+	case pmtdomain.TopicPaymentCaptured:
+		var p pmtdomain.Payment
+		if err := json.Unmarshal(event.Payload, &p); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		return domain.Transfer("payment.captured:"+p.ID, p.OrderID, "payment captured",
+			domain.AccountProcessorClearing, domain.AccountReceivable, p.AmountCents), p.AmountCents > 0, nil
+
+	case pmtdomain.TopicPaymentFailed:
+		var p pmtdomain.Payment
+		if err := json.Unmarshal(event.Payload, &p); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		// only a payment that was captured had reached the clearing account
+		if p.ReceiptID == "" {
+			return domain.JournalEntry{}, false, nil
+		}
+		return domain.Transfer("payment.failed:"+p.ID, p.OrderID, "capture reversed: payment failed",
+			domain.AccountReceivable, domain.AccountProcessorClearing, p.AmountCents), p.AmountCents > 0, nil
+
+	case pmtdomain.TopicPaymentVoided:
+		var p pmtdomain.Payment
+		if err := json.Unmarshal(event.Payload, &p); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		o, err := s.orders.GetByID(ctx, p.OrderID)
+		if err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		return reversal("payment.voided:"+p.ID, o, "sale reversed: authorization voided", p.AmountCents), p.AmountCents > 0, nil
+
+	case pmtdomain.TopicPaymentRefunded:
+		var r pmtdomain.Refund
+		if err := json.Unmarshal(event.Payload, &r); err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		o, err := s.orders.GetByID(ctx, r.OrderID)
+		if err != nil {
+			return domain.JournalEntry{}, false, err
+		}
+		// the tax on the refunded amount is no longer owed
+		tax := taxShare(o, r.AmountCents)
+		return domain.NewEntry("payment.refunded:"+r.ID, r.OrderID, "refund issued",
+			domain.Posting{AccountCode: domain.AccountRefunds, AmountCents: r.AmountCents - tax},
+			domain.Posting{AccountCode: domain.AccountTaxPayable, AmountCents: tax},
+			domain.Posting{AccountCode: domain.AccountProcessorClearing, AmountCents: -r.AmountCents},
+		), r.AmountCents > 0, nil
+	}
+
+	return domain.JournalEntry{}, false, nil
+}
+
+// reversal undoes amountCents of an order's sale, including its share of tax.
+func reversal(key string, o orderdomain.Order, description string, amountCents int64) domain.JournalEntry {
+	tax := taxShare(o, amountCents)
+	return domain.NewEntry(key, o.ID, description,
+		domain.Posting{AccountCode: domain.AccountSales, AmountCents: amountCents - tax},
+		domain.Posting{AccountCode: domain.AccountTaxPayable, AmountCents: tax},
+		domain.Posting{AccountCode: domain.AccountReceivable, AmountCents: -amountCents},
+	)
+}
+
+// taxShare is the part of amountCents of an order that is tax, in the
+// proportion of the order's tax to its total.
+func taxShare(o orderdomain.Order, amountCents int64) int64 {
+	if o.TaxCents <= 0 || o.TotalCents <= 0 {
+		return 0
+	}
+	if amountCents >= o.TotalCents {
+		return o.TaxCents
+	}
+	return amountCents * o.TaxCents / o.TotalCents
+}
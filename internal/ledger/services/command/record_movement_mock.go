@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ledger/services/command/record_movement.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/outbox/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRecordMovementService is a mock of RecordMovementService interface.
+type MockRecordMovementService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordMovementServiceMockRecorder
+}
+
+// MockRecordMovementServiceMockRecorder is the mock recorder for MockRecordMovementService.
+type MockRecordMovementServiceMockRecorder struct {
+	mock *MockRecordMovementService
+}
+
+// NewMockRecordMovementService creates a new mock instance.
+func NewMockRecordMovementService(ctrl *gomock.Controller) *MockRecordMovementService {
+	mock := &MockRecordMovementService{ctrl: ctrl}
+	mock.recorder = &MockRecordMovementServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordMovementService) EXPECT() *MockRecordMovementServiceMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockRecordMovementService) Record(ctx context.Context, event domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockRecordMovementServiceMockRecorder) Record(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRecordMovementService)(nil).Record), ctx, event)
+}
@@ -0,0 +1,179 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	repo "r2-challenge/internal/ledger/adapters/db"
+	"r2-challenge/internal/ledger/domain"
+	orderdomain "r2-challenge/internal/order/domain"
+	orderqry "r2-challenge/internal/order/services/query"
+	outboxdomain "r2-challenge/internal/outbox/domain"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func event(t *testing.T, topic string, payload any) outboxdomain.Event {
+	t.Helper()
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return outboxdomain.Event{ID: "e1", Topic: topic, Payload: body}
+}
+
+func TestRecord_PostsBalancedEntries(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockLedgerRepository(ctrl)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	s, _ := NewRecordMovementService(r, orders, tracer)
+	// untaxed orders
+	orders.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(orderdomain.Order{TotalCents: 1500}, nil).AnyTimes()
+
+	cases := []struct {
+		event  outboxdomain.Event
+		key    string
+		debit  string
+		credit string
+		amount int64
+	}{
+		{event(t, orderdomain.TopicOrderPlaced, orderdomain.Order{ID: "o1", TotalCents: 1500}), "order.placed:o1", domain.AccountReceivable, domain.AccountSales, 1500},
+		{event(t, pmtdomain.TopicPaymentCaptured, pmtdomain.Payment{ID: "p1", OrderID: "o1", AmountCents: 1500}), "payment.captured:p1", domain.AccountProcessorClearing, domain.AccountReceivable, 1500},
+		{event(t, pmtdomain.TopicPaymentRefunded, pmtdomain.Refund{ID: "r1", OrderID: "o1", AmountCents: 500}), "payment.refunded:r1", domain.AccountRefunds, domain.AccountProcessorClearing, 500},
+		{event(t, pmtdomain.TopicPaymentVoided, pmtdomain.Payment{ID: "p2", OrderID: "o2", AmountCents: 700}), "payment.voided:p2", domain.AccountSales, domain.AccountReceivable, 700},
+		{event(t, pmtdomain.TopicPaymentFailed, pmtdomain.Payment{ID: "p3", OrderID: "o3", ReceiptID: "rcpt", AmountCents: 900}), "payment.failed:p3", domain.AccountReceivable, domain.AccountProcessorClearing, 900},
+		{event(t, orderdomain.TopicOrderExpired, orderdomain.Order{ID: "o4", TotalCents: 800}), "order.expired:o4", domain.AccountSales, domain.AccountReceivable, 800},
+	}
+
+	for _, tc := range cases {
+		r.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e domain.JournalEntry) (bool, error) {
+			if err := e.Validate(); err != nil {
+				t.Fatalf("%s: %v", tc.key, err)
+			}
+			if e.Key != tc.key || e.Postings[0].AccountCode != tc.debit || e.Postings[1].AccountCode != tc.credit || e.Postings[0].AmountCents != tc.amount {
+				t.Fatalf("unexpected entry for %s: %+v", tc.event.Topic, e)
+			}
+			return true, nil
+		})
+		if err := s.Record(context.Background(), tc.event); err != nil {
+			t.Fatalf("%s: %v", tc.event.Topic, err)
+		}
+	}
+}
+
+func TestRecord_FailedCheckoutReversesSale(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockLedgerRepository(ctrl)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	s, _ := NewRecordMovementService(r, orders, tracer)
+
+	orders.EXPECT().GetByID(gomock.Any(), "o1").Return(orderdomain.Order{ID: "o1", TotalCents: 1500}, nil)
+	r.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e domain.JournalEntry) (bool, error) {
+		if e.Key != "order.payment_failed:o1" || e.Postings[0].AccountCode != domain.AccountSales || e.Postings[1].AccountCode != domain.AccountReceivable {
+			t.Fatalf("unexpected entry: %+v", e)
+		}
+		return true, nil
+	})
+
+	err := s.Record(context.Background(), event(t, orderdomain.TopicOrderStatusChanged, orderdomain.StatusChanged{OrderID: "o1", From: orderdomain.StatusCreated, To: orderdomain.StatusPaymentFailed}))
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+}
+
+func TestRecord_SplitsTaxOffSales(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockLedgerRepository(ctrl)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	s, _ := NewRecordMovementService(r, orders, tracer)
+
+	order := orderdomain.Order{ID: "o1", TaxCents: 100, TotalCents: 1100}
+	orders.EXPECT().GetByID(gomock.Any(), "o1").Return(order, nil).AnyTimes()
+
+	cases := []struct {
+		event    outboxdomain.Event
+		postings map[string]int64
+	}{
+		{event(t, orderdomain.TopicOrderPlaced, order), map[string]int64{
+			domain.AccountReceivable: 1100, domain.AccountSales: -1000, domain.AccountTaxPayable: -100,
+		}},
+		{event(t, pmtdomain.TopicPaymentRefunded, pmtdomain.Refund{ID: "r1", OrderID: "o1", AmountCents: 550}), map[string]int64{
+			domain.AccountRefunds: 500, domain.AccountTaxPayable: 50, domain.AccountProcessorClearing: -550,
+		}},
+		{event(t, pmtdomain.TopicPaymentVoided, pmtdomain.Payment{ID: "p1", OrderID: "o1", AmountCents: 1100}), map[string]int64{
+			domain.AccountSales: 1000, domain.AccountTaxPayable: 100, domain.AccountReceivable: -1100,
+		}},
+	}
+
+	for _, tc := range cases {
+		r.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e domain.JournalEntry) (bool, error) {
+			if err := e.Validate(); err != nil {
+				t.Fatalf("%s: %v", tc.event.Topic, err)
+			}
+			if len(e.Postings) != len(tc.postings) {
+				t.Fatalf("%s: unexpected postings: %+v", tc.event.Topic, e.Postings)
+			}
+			for _, p := range e.Postings {
+				if tc.postings[p.AccountCode] != p.AmountCents {
+					t.Fatalf("%s: unexpected postings: %+v", tc.event.Topic, e.Postings)
+				}
+			}
+			return true, nil
+		})
+		if err := s.Record(context.Background(), tc.event); err != nil {
+			t.Fatalf("%s: %v", tc.event.Topic, err)
+		}
+	}
+}
+
+func TestRecord_SkipsEventsThatMoveNoMoney(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockLedgerRepository(ctrl)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	s, _ := NewRecordMovementService(r, orders, tracer)
+
+	// no Post expected
+	events := []outboxdomain.Event{
+		event(t, orderdomain.TopicOrderStatusChanged, orderdomain.StatusChanged{OrderID: "o1", From: orderdomain.StatusPaid, To: orderdomain.StatusFulfilled}),
+		event(t, orderdomain.TopicOrderPlaced, orderdomain.Order{ID: "o2"}),
+		event(t, pmtdomain.TopicPaymentFailed, pmtdomain.Payment{ID: "p1", AuthorizationID: "auth_1", AmountCents: 500}),
+		event(t, pmtdomain.TopicPaymentAuthorized, pmtdomain.Payment{ID: "p2", AmountCents: 500}),
+	}
+	for _, e := range events {
+		if err := s.Record(context.Background(), e); err != nil {
+			t.Fatalf("%s: %v", e.Topic, err)
+		}
+	}
+}
+
+func TestJournalEntry_Validate(t *testing.T) {
+	if err := domain.Transfer("k", "", "", domain.AccountReceivable, domain.AccountSales, 100).Validate(); err != nil {
+		t.Fatalf("transfer should balance: %v", err)
+	}
+
+	unbalanced := domain.JournalEntry{Key: "k", Postings: []domain.Posting{
+		{AccountCode: domain.AccountReceivable, AmountCents: 100},
+		{AccountCode: domain.AccountSales, AmountCents: -90},
+	}}
+	if err := unbalanced.Validate(); err == nil {
+		t.Fatalf("expected unbalanced entry to fail")
+	}
+	if err := (domain.JournalEntry{Key: "k", Postings: []domain.Posting{{AccountCode: domain.AccountSales, AmountCents: 100}}}).Validate(); err == nil {
+		t.Fatalf("expected single posting to fail")
+	}
+}
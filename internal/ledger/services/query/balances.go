@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/ledger/adapters/db"
+	"r2-challenge/internal/ledger/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type BalancesService interface {
+	Balances(ctx context.Context) ([]domain.Balance, error)
+	Balance(ctx context.Context, accountCode string) (domain.Balance, error)
+}
+
+type balancesService struct {
+	repo   repo.LedgerRepository
+	tracer observability.Tracer
+}
+
+func NewBalancesService(r repo.LedgerRepository, t observability.Tracer) (BalancesService, error) {
+	return &balancesService{repo: r, tracer: t}, nil
+}
+
+func (s *balancesService) Balances(ctx context.Context) ([]domain.Balance, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "LedgerQuery.Balances")
+	defer span.End()
+
+	balances, err := s.repo.Balances(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+func (s *balancesService) Balance(ctx context.Context, accountCode string) (domain.Balance, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "LedgerQuery.Balance")
+	defer span.End()
+
+	balance, err := s.repo.Balance(ctx, accountCode)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Balance{}, err
+	}
+
+	return balance, nil
+}
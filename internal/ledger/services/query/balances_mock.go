@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ledger/services/query/balances.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/ledger/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBalancesService is a mock of BalancesService interface.
+type MockBalancesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalancesServiceMockRecorder
+}
+
+// MockBalancesServiceMockRecorder is the mock recorder for MockBalancesService.
+type MockBalancesServiceMockRecorder struct {
+	mock *MockBalancesService
+}
+
+// NewMockBalancesService creates a new mock instance.
+func NewMockBalancesService(ctrl *gomock.Controller) *MockBalancesService {
+	mock := &MockBalancesService{ctrl: ctrl}
+	mock.recorder = &MockBalancesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalancesService) EXPECT() *MockBalancesServiceMockRecorder {
+	return m.recorder
+}
+
+// Balance mocks base method.
+func (m *MockBalancesService) Balance(ctx context.Context, accountCode string) (domain.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balance", ctx, accountCode)
+	ret0, _ := ret[0].(domain.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balance indicates an expected call of Balance.
+func (mr *MockBalancesServiceMockRecorder) Balance(ctx, accountCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBalancesService)(nil).Balance), ctx, accountCode)
+}
+
+// Balances mocks base method.
+func (m *MockBalancesService) Balances(ctx context.Context) ([]domain.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balances", ctx)
+	ret0, _ := ret[0].([]domain.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balances indicates an expected call of Balances.
+func (mr *MockBalancesServiceMockRecorder) Balances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balances", reflect.TypeOf((*MockBalancesService)(nil).Balances), ctx)
+}
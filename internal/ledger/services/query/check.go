@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/ledger/adapters/db"
+	"r2-challenge/internal/ledger/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type CheckService interface {
+	// Check reports whether every journal entry balances to zero.
+	Check(ctx context.Context) (domain.Check, error)
+}
+
+type checkService struct {
+	repo   repo.LedgerRepository
+	tracer observability.Tracer
+}
+
+func NewCheckService(r repo.LedgerRepository, t observability.Tracer) (CheckService, error) {
+	return &checkService{repo: r, tracer: t}, nil
+}
+
+func (s *checkService) Check(ctx context.Context) (domain.Check, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "LedgerQuery.Check")
+	defer span.End()
+
+	check, err := s.repo.Check(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Check{}, err
+	}
+
+	return check, nil
+}
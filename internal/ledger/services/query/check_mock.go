@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ledger/services/query/check.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/ledger/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCheckService is a mock of CheckService interface.
+type MockCheckService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckServiceMockRecorder
+}
+
+// MockCheckServiceMockRecorder is the mock recorder for MockCheckService.
+type MockCheckServiceMockRecorder struct {
+	mock *MockCheckService
+}
+
+// NewMockCheckService creates a new mock instance.
+func NewMockCheckService(ctrl *gomock.Controller) *MockCheckService {
+	mock := &MockCheckService{ctrl: ctrl}
+	mock.recorder = &MockCheckServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckService) EXPECT() *MockCheckServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockCheckService) Check(ctx context.Context) (domain.Check, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(domain.Check)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockCheckServiceMockRecorder) Check(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockCheckService)(nil).Check), ctx)
+}
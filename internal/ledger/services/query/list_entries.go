@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/ledger/adapters/db"
+	"r2-challenge/internal/ledger/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListEntriesService interface {
+	List(ctx context.Context, filter repo.EntryFilter) ([]domain.JournalEntry, error)
+}
+
+type listEntriesService struct {
+	repo   repo.LedgerRepository
+	tracer observability.Tracer
+}
+
+func NewListEntriesService(r repo.LedgerRepository, t observability.Tracer) (ListEntriesService, error) {
+	return &listEntriesService{repo: r, tracer: t}, nil
+}
+
+func (s *listEntriesService) List(ctx context.Context, filter repo.EntryFilter) ([]domain.JournalEntry, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "LedgerQuery.ListEntries")
+	defer span.End()
+
+	entries, err := s.repo.ListEntries(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return entries, nil
+}
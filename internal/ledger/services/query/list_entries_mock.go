@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/ledger/services/query/list_entries.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	db "r2-challenge/internal/ledger/adapters/db"
+	domain "r2-challenge/internal/ledger/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListEntriesService is a mock of ListEntriesService interface.
+type MockListEntriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListEntriesServiceMockRecorder
+}
+
+// MockListEntriesServiceMockRecorder is the mock recorder for MockListEntriesService.
+type MockListEntriesServiceMockRecorder struct {
+	mock *MockListEntriesService
+}
+
+// NewMockListEntriesService creates a new mock instance.
+func NewMockListEntriesService(ctrl *gomock.Controller) *MockListEntriesService {
+	mock := &MockListEntriesService{ctrl: ctrl}
+	mock.recorder = &MockListEntriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListEntriesService) EXPECT() *MockListEntriesServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListEntriesService) List(ctx context.Context, filter db.EntryFilter) ([]domain.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]domain.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListEntriesServiceMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListEntriesService)(nil).List), ctx, filter)
+}
@@ -2,12 +2,15 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"r2-challenge/internal/order/domain"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/auth"
 	appdb "r2-challenge/pkg/db"
 	"r2-challenge/pkg/observability"
 
@@ -34,59 +37,171 @@ func (r *dbOrderRepository) Save(ctx context.Context, order domain.Order) (domai
 	order.CreatedAt = now
 	order.UpdatedAt = now
 
-	tx := r.db.WithContext(ctx).Begin()
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Atomic inventory check and decrement per item; the unit price comes
+		// from the catalog row locked by the same statement, never the client.
+		// Stock held by other users' active reservations is not available.
+		// discounts, taxes and shipping are applied afterwards with ApplyPricing
+		order.TotalCents = 0
+		order.DiscountCents = 0
+		order.TaxCents = 0
+		order.ShippingCents = 0
+		order.CouponCode = ""
+		for i := range order.Items {
+			it := &order.Items[i]
+			var product struct {
+				Name        string
+				PriceCents  int64
+				Category    string
+				WeightGrams int64
+			}
+			res := tx.Raw(`UPDATE products SET inventory = inventory - ?
+				WHERE id = ? AND inventory - COALESCE((
+					SELECT SUM(quantity) FROM reservations
+					WHERE product_id = ? AND user_id <> ? AND status = 'active' AND expires_at > ?
+				), 0) >= ?
+				RETURNING name, price_cents, category, weight_grams`, it.Quantity, it.ProductID, it.ProductID, order.UserID, now, it.Quantity).Scan(&product)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return gorm.ErrInvalidData
+			}
+			if it.ExpectedPriceCents > 0 && it.ExpectedPriceCents != product.PriceCents {
+				return domain.PriceChangedError{ProductID: it.ProductID, ExpectedCents: it.ExpectedPriceCents, ActualCents: product.PriceCents}
+			}
+			it.ProductName = product.Name
+			it.PriceCents = product.PriceCents
+			it.Category = product.Category
+			it.WeightGrams = product.WeightGrams
+			it.SubtotalCents = it.PriceCents * it.Quantity
+			it.DiscountCents, it.TaxRateBps, it.TaxCents = 0, 0, 0
+			it.TotalCents = it.SubtotalCents
+			order.TotalCents += it.SubtotalCents
+		}
+		order.SubtotalCents = order.TotalCents
+
+		// prevent auto-saving associations (items)
+		if err := tx.Omit(clause.Associations).Table("orders").Create(&order).Error; err != nil {
+			return err
+		}
+
+		for i := range order.Items {
+			order.Items[i].OrderID = order.ID
+			if order.Items[i].ID == "" {
+				order.Items[i].ID = uuid.NewString()
+			}
+		}
+
+		if len(order.Items) == 0 {
+			return recordEvent(ctx, tx, order.ID, "", order.Status, "order placed")
+		}
+
+		// ids are generated above so the returned items can be addressed later
+		if err := tx.Table("order_items").Create(&order.Items).Error; err != nil {
+			return err
+		}
 
-	// prevent auto-saving associations (items)
-	if err := tx.Omit(clause.Associations).Table("orders").Create(&order).Error; err != nil {
+		if err := convertReservations(tx, order, now); err != nil {
+			return err
+		}
+		return recordEvent(ctx, tx, order.ID, "", order.Status, "order placed")
+	})
+	if err != nil {
 		span.RecordError(err)
-		tx.Rollback()
 		return domain.Order{}, err
 	}
 
-	for i := range order.Items {
-		order.Items[i].OrderID = order.ID
-		if order.Items[i].ID == "" {
-			order.Items[i].ID = uuid.NewString()
-		}
-	}
+	return order, nil
+}
+
+func (r *dbOrderRepository) ApplyPricing(ctx context.Context, order domain.Order) (domain.Order, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.ApplyPricing")
+	defer span.End()
 
-	if len(order.Items) > 0 {
-		// Atomic inventory check and decrement per item
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if order.ShippingCents < 0 {
+			return fmt.Errorf("shipping of order %s is negative", order.ID)
+		}
+		var discount, tax int64
 		for _, it := range order.Items {
-			res := tx.Exec("UPDATE products SET inventory = inventory - ? WHERE id = ? AND inventory >= ?", it.Quantity, it.ProductID, it.Quantity)
+			subtotal := it.PriceCents * it.Quantity
+			if it.DiscountCents < 0 || it.DiscountCents > subtotal {
+				return fmt.Errorf("discount of item %s exceeds its price", it.ID)
+			}
+			if it.TaxCents < 0 || it.TaxRateBps < 0 {
+				return fmt.Errorf("tax of item %s is negative", it.ID)
+			}
+			discount += it.DiscountCents
+			tax += it.TaxCents
+			if it.DiscountCents == 0 && it.TaxRateBps == 0 && it.TaxCents == 0 {
+				continue
+			}
+			res := tx.Table("order_items").Where("id = ? AND order_id = ?", it.ID, order.ID).Updates(map[string]any{
+				"discount_cents": it.DiscountCents,
+				"tax_rate_bps":   it.TaxRateBps,
+				"tax_cents":      it.TaxCents,
+				"total_cents":    subtotal - it.DiscountCents + it.TaxCents,
+			})
 			if res.Error != nil {
-				span.RecordError(res.Error)
-				tx.Rollback()
-				return domain.Order{}, res.Error
+				return res.Error
 			}
 			if res.RowsAffected == 0 {
-				tx.Rollback()
-				return domain.Order{}, gorm.ErrInvalidData
+				return gorm.ErrRecordNotFound
 			}
 		}
-		if err := tx.Table("order_items").Omit("id").Create(&order.Items).Error; err != nil {
-			span.RecordError(err)
-			tx.Rollback()
-			return domain.Order{}, err
+
+		res := tx.Table("orders").Where("id = ?", order.ID).Updates(map[string]any{
+			"coupon_code":    order.CouponCode,
+			"discount_cents": discount,
+			"tax_cents":      tax,
+			"shipping_cents": order.ShippingCents,
+			"total_cents":    gorm.Expr("subtotal_cents - ? + ? + ?", discount, tax, order.ShippingCents),
+			"updated_at":     time.Now().UTC(),
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
 		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
 	}
 
-	if err := tx.Commit().Error; err != nil {
+	return r.GetByID(ctx, order.ID)
+}
+
+func (r *dbOrderRepository) UpdateStatus(ctx context.Context, id string, from string, to string, reason string) (domain.Order, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.UpdateStatus")
+	defer span.End()
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		return transition(ctx, tx, id, from, to, reason)
+	})
+	if err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
 	}
 
-	return order, nil
+	return r.GetByID(ctx, id)
 }
 
-func (r *dbOrderRepository) UpdateStatus(ctx context.Context, id string, status string) (domain.Order, error) {
-	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.UpdateStatus")
+func (r *dbOrderRepository) Release(ctx context.Context, id string, from string, to string, reason string) (domain.Order, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.Release")
 	defer span.End()
 
-	if err := r.db.WithContext(ctx).Table("orders").Where("id = ?", id).Updates(map[string]any{
-		"status":     status,
-		"updated_at": time.Now().UTC(),
-	}).Error; err != nil {
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := transition(ctx, tx, id, from, to, reason); err != nil {
+			return err
+		}
+
+		return restock(tx, id)
+	})
+	if err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
 	}
@@ -94,18 +209,102 @@ func (r *dbOrderRepository) UpdateStatus(ctx context.Context, id string, status
 	return r.GetByID(ctx, id)
 }
 
+// transition moves the order from status `from` to `to` and records the
+// change on its timeline. The compare-and-set on the previous status keeps
+// concurrent updates from racing.
+func transition(ctx context.Context, tx *gorm.DB, id string, from string, to string, reason string) error {
+	res := tx.Table("orders").Where("id = ? AND status = ?", id, from).Updates(map[string]any{
+		"status":     to,
+		"updated_at": time.Now().UTC(),
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrStatusConflict
+	}
+
+	return recordEvent(ctx, tx, id, from, to, reason)
+}
+
+// recordEvent appends a status change to the order's timeline; the actor is
+// the user the request in ctx was authenticated as, if any.
+func recordEvent(ctx context.Context, tx *gorm.DB, orderID string, from string, to string, reason string) error {
+	return tx.Exec(`INSERT INTO order_events (order_id, from_status, to_status, actor_id, reason, created_at)
+		VALUES (?, NULLIF(?, ''), ?, NULLIF(?, '')::uuid, ?, ?)`,
+		orderID, from, to, auth.UserIDFrom(ctx), reason, time.Now().UTC()).Error
+}
+
+// convertReservations consumes the user's active holds on the ordered
+// products, soonest to expire first, up to the ordered quantity; the order now
+// owns that stock. Whatever the holds keep beyond it is released, splitting a
+// hold that is only partly used.
+func convertReservations(tx *gorm.DB, order domain.Order, now time.Time) error {
+	ordered := make(map[string]int64, len(order.Items))
+	productIDs := make([]string, 0, len(order.Items))
+	for _, it := range order.Items {
+		if _, ok := ordered[it.ProductID]; !ok {
+			productIDs = append(productIDs, it.ProductID)
+		}
+		ordered[it.ProductID] += it.Quantity
+	}
+
+	var holds []struct {
+		ID        string
+		ProductID string
+		Quantity  int64
+	}
+	if err := tx.Raw(`SELECT id, product_id, quantity FROM reservations
+		WHERE user_id = ? AND product_id IN ? AND status = 'active' AND expires_at > ?
+		ORDER BY expires_at, id FOR UPDATE`, order.UserID, productIDs, now).Scan(&holds).Error; err != nil {
+		return err
+	}
+
+	for _, h := range holds {
+		used := min(h.Quantity, ordered[h.ProductID])
+		ordered[h.ProductID] -= used
+
+		if used == 0 {
+			if err := tx.Exec(`UPDATE reservations SET status = 'released', updated_at = ? WHERE id = ?`, now, h.ID).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if used < h.Quantity {
+			if err := tx.Exec(`INSERT INTO reservations (user_id, product_id, quantity, status, expires_at, created_at, updated_at)
+				SELECT user_id, product_id, ?, 'released', expires_at, created_at, ? FROM reservations WHERE id = ?`,
+				h.Quantity-used, now, h.ID).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Exec(`UPDATE reservations SET status = 'converted', quantity = ?, order_id = ?, updated_at = ? WHERE id = ?`,
+			used, order.ID, now, h.ID).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restock gives back the inventory held by every item of the order.
+func restock(tx *gorm.DB, orderID string) error {
+	return tx.Exec(`UPDATE products p SET inventory = p.inventory + oi.quantity, updated_at = ?
+		FROM (SELECT product_id, SUM(quantity) AS quantity FROM order_items WHERE order_id = ? GROUP BY product_id) oi
+		WHERE p.id = oi.product_id`, time.Now().UTC(), orderID).Error
+}
+
 func (r *dbOrderRepository) GetByID(ctx context.Context, orderID string) (domain.Order, error) {
 	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.GetByID")
 	defer span.End()
 
 	var order domain.Order
-	if err := r.db.WithContext(ctx).Table("orders").Where("id = ?", orderID).First(&order).Error; err != nil {
+	if err := appdb.Conn(ctx, r.db).Table("orders").Where("id = ?", orderID).First(&order).Error; err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
 	}
 	var items []domain.OrderItem
 
-	if err := r.db.WithContext(ctx).Table("order_items").Where("order_id = ?", orderID).Find(&items).Error; err != nil {
+	if err := appdb.Conn(ctx, r.db).Table("order_items").Where("order_id = ?", orderID).Find(&items).Error; err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
 	}
@@ -119,7 +318,7 @@ func (r *dbOrderRepository) ListByUser(ctx context.Context, userID string, filte
 	defer span.End()
 
 	var orders []domain.Order
-	query := r.db.WithContext(ctx).Table("orders").Where("user_id = ?", userID)
+	query := appdb.Conn(ctx, r.db).Table("orders").Where("user_id = ?", userID)
 
 	if filter.Limit > 0 {
 		query = query.Limit(filter.Limit)
@@ -134,8 +333,100 @@ func (r *dbOrderRepository) ListByUser(ctx context.Context, userID string, filte
 		return nil, err
 	}
 
+	if err := r.loadItems(ctx, orders); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *dbOrderRepository) Search(ctx context.Context, search OrderSearch) (OrderPage, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.Search")
+	defer span.End()
+
+	if search.SortBy == "" {
+		search.SortBy = SortByCreatedAt
+	}
+	if search.SortBy != SortByCreatedAt && search.SortBy != SortByTotalCents {
+		err := fmt.Errorf("unknown sort %q", search.SortBy)
+		span.RecordError(err)
+		return OrderPage{}, err
+	}
+
+	query := appdb.Conn(ctx, r.db).Table("orders")
+	if len(search.Statuses) > 0 {
+		query = query.Where("status IN ?", search.Statuses)
+	}
+	if search.UserID != "" {
+		query = query.Where("user_id = ?", search.UserID)
+	}
+	if search.ProductID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM order_items oi WHERE oi.order_id = orders.id AND oi.product_id = ?)", search.ProductID)
+	}
+	if search.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *search.CreatedFrom)
+	}
+	if search.CreatedTo != nil {
+		query = query.Where("created_at < ?", *search.CreatedTo)
+	}
+	if search.MinTotalCents != nil {
+		query = query.Where("total_cents >= ?", *search.MinTotalCents)
+	}
+	if search.MaxTotalCents != nil {
+		query = query.Where("total_cents <= ?", *search.MaxTotalCents)
+	}
+
+	// keyset pagination: continue strictly after the (sort key, id) of the
+	// previous page's last order
+	dir, cmp := "ASC", ">"
+	if search.SortDesc {
+		dir, cmp = "DESC", "<"
+	}
+	if search.Cursor != "" {
+		c, err := decodeCursor(search)
+		if err != nil {
+			span.RecordError(err)
+			return OrderPage{}, err
+		}
+		if search.SortBy == SortByTotalCents {
+			query = query.Where("(total_cents, id) "+cmp+" (?, ?)", *c.TotalCents, c.ID)
+		} else {
+			query = query.Where("(created_at, id) "+cmp+" (?, ?)", *c.CreatedAt, c.ID)
+		}
+	}
+	query = query.Order(search.SortBy + " " + dir).Order("id " + dir)
+
+	switch {
+	case search.Limit <= 0:
+		search.Limit = 50
+	case search.Limit > 200:
+		search.Limit = 200
+	}
+	// one extra row tells whether another page follows
+	orders := []domain.Order{}
+	if err := query.Limit(search.Limit + 1).Find(&orders).Error; err != nil {
+		span.RecordError(err)
+		return OrderPage{}, err
+	}
+
+	page := OrderPage{Orders: orders}
+	if len(orders) > search.Limit {
+		page.Orders = orders[:search.Limit]
+		page.NextCursor = encodeCursor(search, page.Orders[search.Limit-1])
+	}
+	if err := r.loadItems(ctx, page.Orders); err != nil {
+		span.RecordError(err)
+		return OrderPage{}, err
+	}
+
+	return page, nil
+}
+
+// loadItems fills in the items of orders with one query.
+func (r *dbOrderRepository) loadItems(ctx context.Context, orders []domain.Order) error {
 	if len(orders) == 0 {
-		return orders, nil
+		return nil
 	}
 
 	ids := make([]string, 0, len(orders))
@@ -144,9 +435,8 @@ func (r *dbOrderRepository) ListByUser(ctx context.Context, userID string, filte
 	}
 
 	var items []domain.OrderItem
-	if err := r.db.WithContext(ctx).Table("order_items").Where("order_id IN ?", ids).Find(&items).Error; err != nil {
-		span.RecordError(err)
-		return nil, err
+	if err := appdb.Conn(ctx, r.db).Table("order_items").Where("order_id IN ?", ids).Find(&items).Error; err != nil {
+		return err
 	}
 
 	itemsByOrder := make(map[string][]domain.OrderItem, len(orders))
@@ -158,5 +448,36 @@ func (r *dbOrderRepository) ListByUser(ctx context.Context, userID string, filte
 		orders[i].Items = itemsByOrder[orders[i].ID]
 	}
 
+	return nil
+}
+
+func (r *dbOrderRepository) ListAwaitingPayment(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.ListAwaitingPayment")
+	defer span.End()
+
+	orders := []domain.Order{}
+	err := appdb.Conn(ctx, r.db).Table("orders").
+		Where("status = ? AND created_at < ?", domain.StatusCreated, createdBefore).
+		Where("NOT EXISTS (SELECT 1 FROM payments p WHERE p.order_id = orders.id AND p.status NOT IN ?)",
+			[]string{pmtdomain.StatusFailed, pmtdomain.StatusVoided}).
+		Order("created_at").Limit(limit).Find(&orders).Error
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	return orders, nil
 }
+
+func (r *dbOrderRepository) ListEvents(ctx context.Context, orderID string) ([]domain.OrderEvent, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OrderRepository.ListEvents")
+	defer span.End()
+
+	events := []domain.OrderEvent{}
+	if err := appdb.Conn(ctx, r.db).Table("order_events").Where("order_id = ?", orderID).Order("created_at").Order("id").Find(&events).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -8,11 +8,16 @@ import (
 	"path/filepath"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 
 	"r2-challenge/cmd/envs"
 	orderdomain "r2-challenge/internal/order/domain"
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/auth"
 	appdb "r2-challenge/pkg/db"
 	"r2-challenge/pkg/observability"
 )
@@ -63,6 +68,7 @@ func truncateAll(t *testing.T, gdb *appdb.Database) {
 		"TRUNCATE products RESTART IDENTITY CASCADE",
 		"TRUNCATE users RESTART IDENTITY CASCADE",
 		"TRUNCATE payments RESTART IDENTITY CASCADE",
+		"TRUNCATE refunds RESTART IDENTITY CASCADE",
 	}
 	for _, s := range stmts {
 		if err := gdb.Exec(s).Error; err != nil {
@@ -219,3 +225,442 @@ func containsJSONKey(b []byte, key string) bool {
 	_, ok := m[key]
 	return ok
 }
+
+func TestOrderRepository_Release_RestoresInventory(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'c@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'c', 100, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+
+	// the same product on two lines must be restocked in full
+	saved, err := repo.Save(ctx, orderdomain.Order{
+		UserID:     userID,
+		Status:     orderdomain.StatusCreated,
+		TotalCents: 500,
+		Items: []orderdomain.OrderItem{
+			{ProductID: productID, Quantity: 2, PriceCents: 100},
+			{ProductID: productID, Quantity: 3, PriceCents: 100},
+		},
+	})
+	if err != nil {
+		t.Fatalf("save order: %v", err)
+	}
+
+	cancelled, err := repo.Release(ctx, saved.ID, orderdomain.StatusCreated, orderdomain.StatusCancelled, "test")
+	if err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if cancelled.Status != orderdomain.StatusCancelled {
+		t.Fatalf("unexpected status: %s", cancelled.Status)
+	}
+
+	var inventory int64
+	if err := database.Raw(`SELECT inventory FROM products WHERE id = ?`, productID).Scan(&inventory).Error; err != nil {
+		t.Fatalf("read inventory: %v", err)
+	}
+	if inventory != 10 {
+		t.Fatalf("expected inventory 10, got %d", inventory)
+	}
+
+	if _, err := repo.Release(ctx, saved.ID, orderdomain.StatusCreated, orderdomain.StatusCancelled, "test"); !errors.Is(err, orderdomain.ErrStatusConflict) {
+		t.Fatalf("expected ErrStatusConflict on second release, got %v", err)
+	}
+}
+
+func TestOrderRepository_Save_PricesFromCatalog(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'p@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'c', 1234, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+
+	// client-supplied prices are ignored
+	saved, err := repo.Save(ctx, orderdomain.Order{
+		UserID:     userID,
+		Status:     orderdomain.StatusCreated,
+		TotalCents: 1,
+		Items:      []orderdomain.OrderItem{{ProductID: productID, Quantity: 2, PriceCents: 1}},
+	})
+	if err != nil {
+		t.Fatalf("save order: %v", err)
+	}
+	if saved.Items[0].PriceCents != 1234 || saved.TotalCents != 2468 {
+		t.Fatalf("unexpected pricing: item=%d total=%d", saved.Items[0].PriceCents, saved.TotalCents)
+	}
+
+	// a stale expected price fails and leaves inventory untouched
+	_, err = repo.Save(ctx, orderdomain.Order{
+		UserID: userID,
+		Status: orderdomain.StatusCreated,
+		Items:  []orderdomain.OrderItem{{ProductID: productID, Quantity: 1, ExpectedPriceCents: 999}},
+	})
+	var priceErr orderdomain.PriceChangedError
+	if !errors.As(err, &priceErr) {
+		t.Fatalf("expected PriceChangedError, got %v", err)
+	}
+
+	var inventory int64
+	if err := database.Raw(`SELECT inventory FROM products WHERE id = ?`, productID).Scan(&inventory).Error; err != nil {
+		t.Fatalf("read inventory: %v", err)
+	}
+	if inventory != 8 {
+		t.Fatalf("expected inventory 8, got %d", inventory)
+	}
+}
+
+func TestOrderRepository_Save_ConvertsOnlyTheOrderedQuantityOfHolds(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'r@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'c', 100, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+	// holds of 2 and 3 units, the first expiring sooner
+	for _, hold := range []struct {
+		quantity int64
+		ttl      time.Duration
+	}{{2, 5 * time.Minute}, {3, 10 * time.Minute}} {
+		if err := database.Exec(`INSERT INTO reservations (user_id, product_id, quantity, expires_at) VALUES (?, ?, ?, ?)`,
+			userID, productID, hold.quantity, time.Now().UTC().Add(hold.ttl)).Error; err != nil {
+			t.Fatalf("insert reservation: %v", err)
+		}
+	}
+
+	saved, err := repo.Save(context.Background(), orderdomain.Order{
+		UserID: userID,
+		Status: orderdomain.StatusCreated,
+		Items:  []orderdomain.OrderItem{{ProductID: productID, Quantity: 3}},
+	})
+	if err != nil {
+		t.Fatalf("save order: %v", err)
+	}
+
+	var held []struct {
+		Status   string
+		Quantity int64
+	}
+	if err := database.Raw(`SELECT status, SUM(quantity) AS quantity FROM reservations WHERE product_id = ? GROUP BY status ORDER BY status`, productID).Scan(&held).Error; err != nil {
+		t.Fatalf("read reservations: %v", err)
+	}
+	if len(held) != 2 || held[0].Status != "converted" || held[0].Quantity != 3 || held[1].Status != "released" || held[1].Quantity != 2 {
+		t.Fatalf("unexpected reservations: %+v", held)
+	}
+
+	var converted int64
+	if err := database.Raw(`SELECT COUNT(*) FROM reservations WHERE order_id = ?`, saved.ID).Scan(&converted).Error; err != nil {
+		t.Fatalf("count converted: %v", err)
+	}
+	if converted != 2 {
+		t.Fatalf("expected both holds to point to the order, got %d", converted)
+	}
+}
+
+func TestOrderRepository_ApplyPricing_RecomputesTotals(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'd@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'books', 1000, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+
+	saved, err := repo.Save(ctx, orderdomain.Order{UserID: userID, Status: "created", TaxCountry: "US", TaxRegion: "CA",
+		ShippingMethod: "flat", ShippingAddress: &orderdomain.Address{Name: "Jane", Line1: "1 Main St", City: "LA", Region: "CA", Country: "US"},
+		Items: []orderdomain.OrderItem{{ProductID: productID, Quantity: 3}}})
+	if err != nil {
+		t.Fatalf("save order: %v", err)
+	}
+	if saved.SubtotalCents != 3000 || saved.TotalCents != 3000 || saved.Items[0].SubtotalCents != 3000 || saved.Items[0].Category != "books" {
+		t.Fatalf("unexpected saved order: %+v", saved)
+	}
+
+	saved.CouponCode = "SAVE5"
+	saved.Items[0].DiscountCents = 500
+	saved.Items[0].TaxRateBps, saved.Items[0].TaxCents = 1000, 250
+	saved.ShippingCents = 500
+	priced, err := repo.ApplyPricing(ctx, saved)
+	if err != nil {
+		t.Fatalf("apply pricing: %v", err)
+	}
+	if priced.SubtotalCents != 3000 || priced.DiscountCents != 500 || priced.TaxCents != 250 || priced.ShippingCents != 500 || priced.TotalCents != 3250 || priced.CouponCode != "SAVE5" || priced.TaxCountry != "US" || priced.TaxRegion != "CA" {
+		t.Fatalf("unexpected order: %+v", priced)
+	}
+	got, err := repo.GetByID(ctx, priced.ID)
+	if err != nil {
+		t.Fatalf("get order: %v", err)
+	}
+	if got.ShippingMethod != "flat" || got.ShippingCents != 500 || got.ShippingAddress == nil || got.ShippingAddress.Line1 != "1 Main St" {
+		t.Fatalf("unexpected shipping on stored order: %+v", got)
+	}
+	it := priced.Items[0]
+	if len(priced.Items) != 1 || it.SubtotalCents != 3000 || it.DiscountCents != 500 || it.TaxRateBps != 1000 || it.TaxCents != 250 || it.TotalCents != 2750 {
+		t.Fatalf("unexpected items: %+v", priced.Items)
+	}
+
+	saved.Items[0].DiscountCents = 5000
+	if _, err := repo.ApplyPricing(ctx, saved); err == nil {
+		t.Fatalf("expected a discount above the line price to fail")
+	}
+}
+
+func TestShipmentRepository_SaveDeliverAndList(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	orders, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	repo, err := NewShipmentRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new shipment repo: %v", err)
+	}
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 's@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'books', 1000, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+	order, err := orders.Save(ctx, orderdomain.Order{UserID: userID, Status: "paid", Items: []orderdomain.OrderItem{{ProductID: productID, Quantity: 3}}})
+	if err != nil {
+		t.Fatalf("save order: %v", err)
+	}
+
+	if err := repo.Lock(ctx, uuid.NewString()); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("expected not found locking a missing order, got %v", err)
+	}
+
+	saved, err := repo.Save(ctx, orderdomain.Shipment{OrderID: order.ID, Carrier: "ups", TrackingNumber: "1Z1",
+		Items: []orderdomain.ShipmentItem{{OrderItemID: order.Items[0].ID, Quantity: 2}}})
+	if err != nil {
+		t.Fatalf("save shipment: %v", err)
+	}
+	if saved.Status != orderdomain.ShipmentShipped || saved.Items[0].ID == "" {
+		t.Fatalf("unexpected shipment: %+v", saved)
+	}
+
+	delivered, err := repo.MarkDelivered(ctx, order.ID, saved.ID)
+	if err != nil {
+		t.Fatalf("mark delivered: %v", err)
+	}
+	if delivered.Status != orderdomain.ShipmentDelivered || delivered.DeliveredAt == nil || len(delivered.Items) != 1 {
+		t.Fatalf("unexpected delivered shipment: %+v", delivered)
+	}
+	if _, err := repo.MarkDelivered(ctx, order.ID, saved.ID); !errors.Is(err, orderdomain.ErrStatusConflict) {
+		t.Fatalf("expected conflict delivering twice, got %v", err)
+	}
+
+	list, err := repo.ListByOrder(ctx, order.ID)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list) != 1 || len(list[0].Items) != 1 || list[0].Items[0].Quantity != 2 {
+		t.Fatalf("unexpected shipments: %+v", list)
+	}
+	if left := orderdomain.Unshipped(order, list); left[order.Items[0].ID] != 1 {
+		t.Fatalf("unexpected unshipped quantities: %v", left)
+	}
+}
+
+func TestOrderRepository_Search_PagesByTotal(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'q@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'books', 1000, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+	for _, qty := range []int64{2, 1, 3} {
+		if _, err := repo.Save(ctx, orderdomain.Order{UserID: userID, Status: "created", Items: []orderdomain.OrderItem{{ProductID: productID, Quantity: qty}}}); err != nil {
+			t.Fatalf("save order: %v", err)
+		}
+	}
+
+	search := OrderSearch{UserID: userID, ProductID: productID, SortBy: SortByTotalCents, Limit: 2}
+	first, err := repo.Search(ctx, search)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(first.Orders) != 2 || first.NextCursor == "" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+	if first.Orders[0].TotalCents > first.Orders[1].TotalCents || len(first.Orders[0].Items) != 1 {
+		t.Fatalf("first page not sorted by total: %+v", first.Orders)
+	}
+
+	search.Cursor = first.NextCursor
+	second, err := repo.Search(ctx, search)
+	if err != nil {
+		t.Fatalf("search second page: %v", err)
+	}
+	if len(second.Orders) != 1 || second.NextCursor != "" || second.Orders[0].TotalCents < first.Orders[1].TotalCents {
+		t.Fatalf("unexpected second page: %+v", second)
+	}
+
+	// a cursor only continues the sort order it was issued for
+	search.SortDesc = true
+	if _, err := repo.Search(ctx, search); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestOrderRepository_StatusChanges_RecordTimeline(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	adminID := uuid.NewString()
+	productID := uuid.NewString()
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'h@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'books', 1000, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+	order, err := repo.Save(auth.WithUserID(ctx, userID), orderdomain.Order{UserID: userID, Status: orderdomain.StatusCreated, Items: []orderdomain.OrderItem{{ProductID: productID, Quantity: 1}}})
+	if err != nil {
+		t.Fatalf("save order: %v", err)
+	}
+	if _, err := repo.UpdateStatus(auth.WithUserID(ctx, adminID), order.ID, orderdomain.StatusCreated, orderdomain.StatusPaid, "paid by wire"); err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+	// a conflicting change leaves no trace
+	if _, err := repo.UpdateStatus(ctx, order.ID, orderdomain.StatusCreated, orderdomain.StatusPaid, ""); !errors.Is(err, orderdomain.ErrStatusConflict) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	if _, err := repo.Release(ctx, order.ID, orderdomain.StatusPaid, orderdomain.StatusCancelled, "order cancelled"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+
+	events, err := repo.ListEvents(ctx, order.ID)
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	want := []orderdomain.OrderEvent{
+		{FromStatus: "", ToStatus: orderdomain.StatusCreated, ActorID: userID, Reason: "order placed"},
+		{FromStatus: orderdomain.StatusCreated, ToStatus: orderdomain.StatusPaid, ActorID: adminID, Reason: "paid by wire"},
+		{FromStatus: orderdomain.StatusPaid, ToStatus: orderdomain.StatusCancelled, ActorID: "", Reason: "order cancelled"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), events)
+	}
+	for i, e := range events {
+		if e.OrderID != order.ID || e.FromStatus != want[i].FromStatus || e.ToStatus != want[i].ToStatus || e.ActorID != want[i].ActorID || e.Reason != want[i].Reason {
+			t.Fatalf("unexpected event %d: %+v", i, e)
+		}
+	}
+}
+
+func TestOrderRepository_ListAwaitingPayment_SkipsPaidAndRecentOrders(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	repo, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	payments, err := pmtdb.NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new payment repo: %v", err)
+	}
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	productID := uuid.NewString()
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'x@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO products (id, name, description, category, price_cents, inventory) VALUES (?, 'P', 'D', 'c', 1000, 10)`, productID).Error; err != nil {
+		t.Fatalf("insert product: %v", err)
+	}
+
+	// unpaid, captured, failed, recent and pending orders; all but the recent
+	// one are a day old
+	ids := make([]string, 5)
+	for i := range ids {
+		saved, err := repo.Save(ctx, orderdomain.Order{UserID: userID, Status: orderdomain.StatusCreated, Items: []orderdomain.OrderItem{{ProductID: productID, Quantity: 1}}})
+		if err != nil {
+			t.Fatalf("save order: %v", err)
+		}
+		ids[i] = saved.ID
+	}
+	if err := database.Exec(`UPDATE orders SET created_at = NOW() - INTERVAL '1 day' WHERE id IN ?`, []string{ids[0], ids[1], ids[2], ids[4]}).Error; err != nil {
+		t.Fatalf("age orders: %v", err)
+	}
+	if _, err := payments.Save(ctx, pmtdomain.Payment{OrderID: ids[1], UserID: userID, AmountCents: 1000, Provider: "mock", ReceiptID: "rcpt_1", Status: pmtdomain.StatusCaptured}); err != nil {
+		t.Fatalf("save payment: %v", err)
+	}
+	if _, err := payments.Save(ctx, pmtdomain.Payment{OrderID: ids[2], UserID: userID, AmountCents: 1000, Provider: "mock", Status: pmtdomain.StatusFailed}); err != nil {
+		t.Fatalf("save payment: %v", err)
+	}
+	// a charge whose result was never recorded may have collected the money
+	if _, err := payments.Save(ctx, pmtdomain.Payment{OrderID: ids[4], UserID: userID, AmountCents: 1000, Provider: "mock", Status: pmtdomain.StatusPending}); err != nil {
+		t.Fatalf("save payment: %v", err)
+	}
+
+	list, err := repo.ListAwaitingPayment(ctx, time.Now().UTC().Add(-time.Hour), 10)
+	if err != nil {
+		t.Fatalf("list awaiting payment: %v", err)
+	}
+	got := map[string]bool{}
+	for _, o := range list {
+		got[o.ID] = true
+	}
+	if len(list) != 2 || !got[ids[0]] || !got[ids[2]] {
+		t.Fatalf("expected orders %s and %s, got %+v", ids[0], ids[2], list)
+	}
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"r2-challenge/internal/order/domain"
 )
@@ -14,7 +15,31 @@ type OrderFilter struct {
 
 type OrderRepository interface {
 	Save(ctx context.Context, order domain.Order) (domain.Order, error)
-	UpdateStatus(ctx context.Context, orderID string, status string) (domain.Order, error)
+	// ApplyPricing stores the coupon code, the ShippingCents and the
+	// DiscountCents, TaxRateBps and TaxCents of each item of an order that
+	// was just saved, and recomputes the item and order totals from them.
+	ApplyPricing(ctx context.Context, order domain.Order) (domain.Order, error)
+	// UpdateStatus moves the order from status `from` to `to`, failing with
+	// domain.ErrStatusConflict when the current status is no longer `from`.
+	// The change is added to the order's timeline with reason and the user
+	// id carried by ctx (see auth.WithUserID).
+	UpdateStatus(ctx context.Context, orderID string, from string, to string, reason string) (domain.Order, error)
+	// Release moves the order from status `from` to `to` and restores the
+	// inventory held by its items, all in one transaction. The change is
+	// added to the timeline like UpdateStatus.
+	Release(ctx context.Context, orderID string, from string, to string, reason string) (domain.Order, error)
 	GetByID(ctx context.Context, orderID string) (domain.Order, error)
 	ListByUser(ctx context.Context, userID string, filter OrderFilter) ([]domain.Order, error)
+	// Search returns one page of the orders of every user matching the
+	// search, with their items. It fails with ErrInvalidCursor when the
+	// cursor does not belong to the search's sort order.
+	Search(ctx context.Context, search OrderSearch) (OrderPage, error)
+	// ListAwaitingPayment returns up to limit orders, oldest first, placed
+	// before createdBefore that are still created and have no payment other
+	// than failed or voided ones, i.e. nothing was ever collected or
+	// authorized for them. A pending payment, whose provider answer was never
+	// recorded, keeps the order out. Items are not loaded.
+	ListAwaitingPayment(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error)
+	// ListEvents returns the timeline of the order, oldest first.
+	ListEvents(ctx context.Context, orderID string) ([]domain.OrderEvent, error)
 }
@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain "r2-challenge/internal/order/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,21 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApplyPricing mocks base method.
+func (m *MockOrderRepository) ApplyPricing(ctx context.Context, order domain.Order) (domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPricing", ctx, order)
+	ret0, _ := ret[0].(domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPricing indicates an expected call of ApplyPricing.
+func (mr *MockOrderRepositoryMockRecorder) ApplyPricing(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPricing", reflect.TypeOf((*MockOrderRepository)(nil).ApplyPricing), ctx, order)
+}
+
 // GetByID mocks base method.
 func (m *MockOrderRepository) GetByID(ctx context.Context, orderID string) (domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +66,21 @@ func (mr *MockOrderRepositoryMockRecorder) GetByID(ctx, orderID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrderRepository)(nil).GetByID), ctx, orderID)
 }
 
+// ListAwaitingPayment mocks base method.
+func (m *MockOrderRepository) ListAwaitingPayment(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAwaitingPayment", ctx, createdBefore, limit)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAwaitingPayment indicates an expected call of ListAwaitingPayment.
+func (mr *MockOrderRepositoryMockRecorder) ListAwaitingPayment(ctx, createdBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAwaitingPayment", reflect.TypeOf((*MockOrderRepository)(nil).ListAwaitingPayment), ctx, createdBefore, limit)
+}
+
 // ListByUser mocks base method.
 func (m *MockOrderRepository) ListByUser(ctx context.Context, userID string, filter OrderFilter) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +96,36 @@ func (mr *MockOrderRepositoryMockRecorder) ListByUser(ctx, userID, filter interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockOrderRepository)(nil).ListByUser), ctx, userID, filter)
 }
 
+// ListEvents mocks base method.
+func (m *MockOrderRepository) ListEvents(ctx context.Context, orderID string) ([]domain.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, orderID)
+	ret0, _ := ret[0].([]domain.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockOrderRepositoryMockRecorder) ListEvents(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockOrderRepository)(nil).ListEvents), ctx, orderID)
+}
+
+// Release mocks base method.
+func (m *MockOrderRepository) Release(ctx context.Context, orderID, from, to, reason string) (domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID, from, to, reason)
+	ret0, _ := ret[0].(domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockOrderRepositoryMockRecorder) Release(ctx, orderID, from, to, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockOrderRepository)(nil).Release), ctx, orderID, from, to, reason)
+}
+
 // Save mocks base method.
 func (m *MockOrderRepository) Save(ctx context.Context, order domain.Order) (domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -80,17 +141,32 @@ func (mr *MockOrderRepositoryMockRecorder) Save(ctx, order interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrderRepository)(nil).Save), ctx, order)
 }
 
+// Search mocks base method.
+func (m *MockOrderRepository) Search(ctx context.Context, search OrderSearch) (OrderPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, search)
+	ret0, _ := ret[0].(OrderPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockOrderRepositoryMockRecorder) Search(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOrderRepository)(nil).Search), ctx, search)
+}
+
 // UpdateStatus mocks base method.
-func (m *MockOrderRepository) UpdateStatus(ctx context.Context, orderID, status string) (domain.Order, error) {
+func (m *MockOrderRepository) UpdateStatus(ctx context.Context, orderID, from, to, reason string) (domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, from, to, reason)
 	ret0, _ := ret[0].(domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockOrderRepositoryMockRecorder) UpdateStatus(ctx, orderID, status interface{}) *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) UpdateStatus(ctx, orderID, from, to, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderRepository)(nil).UpdateStatus), ctx, orderID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockOrderRepository)(nil).UpdateStatus), ctx, orderID, from, to, reason)
 }
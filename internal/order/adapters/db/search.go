@@ -0,0 +1,84 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"r2-challenge/internal/order/domain"
+)
+
+// Sort keys of OrderSearch.
+const (
+	SortByCreatedAt  = "created_at"
+	SortByTotalCents = "total_cents"
+)
+
+// ErrInvalidCursor is returned when a search cursor is malformed or was
+// issued for another sort order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// OrderSearch filters and sorts Search; zero fields match every order. The
+// created_at range includes CreatedFrom and excludes CreatedTo; the total
+// range includes both bounds. Orders are sorted by SortBy (created_at when
+// empty), then id, and Cursor continues after the last order of a previous
+// page. Limit defaults to 50 and is capped at 200.
+type OrderSearch struct {
+	Statuses      []string
+	UserID        string
+	ProductID     string
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	MinTotalCents *int64
+	MaxTotalCents *int64
+	SortBy        string
+	SortDesc      bool
+	Limit         int
+	Cursor        string
+}
+
+// OrderPage is one page of search results; NextCursor is empty on the last page.
+type OrderPage struct {
+	Orders     []domain.Order `json:"orders"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// orderCursor is the position of the last order of a page. Exactly one of
+// CreatedAt and TotalCents is set, matching Sort.
+type orderCursor struct {
+	Sort       string     `json:"s"`
+	Desc       bool       `json:"d"`
+	CreatedAt  *time.Time `json:"c,omitempty"`
+	TotalCents *int64     `json:"t,omitempty"`
+	ID         string     `json:"id"`
+}
+
+func encodeCursor(s OrderSearch, last domain.Order) string {
+	c := orderCursor{Sort: s.SortBy, Desc: s.SortDesc, ID: last.ID}
+	if s.SortBy == SortByTotalCents {
+		c.TotalCents = &last.TotalCents
+	} else {
+		c.CreatedAt = &last.CreatedAt
+	}
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s OrderSearch) (orderCursor, error) {
+	var c orderCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s.Cursor)
+	if err != nil {
+		return orderCursor{}, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == "" {
+		return orderCursor{}, ErrInvalidCursor
+	}
+	if c.Sort != s.SortBy || c.Desc != s.SortDesc {
+		return orderCursor{}, ErrInvalidCursor
+	}
+	if (c.Sort == SortByTotalCents && c.TotalCents == nil) || (c.Sort == SortByCreatedAt && c.CreatedAt == nil) {
+		return orderCursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
@@ -0,0 +1,22 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/order/domain"
+)
+
+// ShipmentRepository stores the shipments of orders. Lookups are scoped to
+// one order, so a shipment of another order is reported as not found.
+type ShipmentRepository interface {
+	// Lock holds the order row until the surrounding transaction ends, so
+	// the shipments of one order are planned one at a time.
+	Lock(ctx context.Context, orderID string) error
+	Save(ctx context.Context, s domain.Shipment) (domain.Shipment, error)
+	// MarkDelivered moves a shipped shipment to delivered, failing with
+	// domain.ErrStatusConflict when it is no longer shipped.
+	MarkDelivered(ctx context.Context, orderID string, id string) (domain.Shipment, error)
+	Get(ctx context.Context, orderID string, id string) (domain.Shipment, error)
+	// ListByOrder returns the shipments of an order with their items, oldest first.
+	ListByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error)
+}
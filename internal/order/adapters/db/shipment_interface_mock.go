@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/adapters/db/shipment_interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShipmentRepository is a mock of ShipmentRepository interface.
+type MockShipmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentRepositoryMockRecorder
+}
+
+// MockShipmentRepositoryMockRecorder is the mock recorder for MockShipmentRepository.
+type MockShipmentRepositoryMockRecorder struct {
+	mock *MockShipmentRepository
+}
+
+// NewMockShipmentRepository creates a new mock instance.
+func NewMockShipmentRepository(ctrl *gomock.Controller) *MockShipmentRepository {
+	mock := &MockShipmentRepository{ctrl: ctrl}
+	mock.recorder = &MockShipmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentRepository) EXPECT() *MockShipmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockShipmentRepository) Get(ctx context.Context, orderID, id string) (domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, orderID, id)
+	ret0, _ := ret[0].(domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockShipmentRepositoryMockRecorder) Get(ctx, orderID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockShipmentRepository)(nil).Get), ctx, orderID, id)
+}
+
+// ListByOrder mocks base method.
+func (m *MockShipmentRepository) ListByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrder indicates an expected call of ListByOrder.
+func (mr *MockShipmentRepositoryMockRecorder) ListByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrder", reflect.TypeOf((*MockShipmentRepository)(nil).ListByOrder), ctx, orderID)
+}
+
+// Lock mocks base method.
+func (m *MockShipmentRepository) Lock(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockShipmentRepositoryMockRecorder) Lock(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockShipmentRepository)(nil).Lock), ctx, orderID)
+}
+
+// MarkDelivered mocks base method.
+func (m *MockShipmentRepository) MarkDelivered(ctx context.Context, orderID, id string) (domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, orderID, id)
+	ret0, _ := ret[0].(domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockShipmentRepositoryMockRecorder) MarkDelivered(ctx, orderID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockShipmentRepository)(nil).MarkDelivered), ctx, orderID, id)
+}
+
+// Save mocks base method.
+func (m *MockShipmentRepository) Save(ctx context.Context, s domain.Shipment) (domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, s)
+	ret0, _ := ret[0].(domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockShipmentRepositoryMockRecorder) Save(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockShipmentRepository)(nil).Save), ctx, s)
+}
@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/order/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbShipmentRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewShipmentRepository(database *appdb.Database, t observability.Tracer) (ShipmentRepository, error) {
+	return &dbShipmentRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbShipmentRepository) Lock(ctx context.Context, orderID string) error {
+	ctx, span := r.tracer.StartSpan(ctx, "ShipmentRepository.Lock")
+	defer span.End()
+
+	var ids []string
+	if err := appdb.Conn(ctx, r.db).Raw("SELECT id FROM orders WHERE id = ? FOR UPDATE", orderID).Scan(&ids).Error; err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if len(ids) == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *dbShipmentRepository) Save(ctx context.Context, s domain.Shipment) (domain.Shipment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ShipmentRepository.Save")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if s.ID == "" {
+		s.ID = uuid.NewString()
+	}
+	s.Status = domain.ShipmentShipped
+	s.ShippedAt = now
+	s.DeliveredAt = nil
+	s.CreatedAt = now
+	s.UpdatedAt = now
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("shipments").Create(&s).Error; err != nil {
+			return err
+		}
+		for i := range s.Items {
+			it := &s.Items[i]
+			it.ID = uuid.NewString()
+			it.ShipmentID = s.ID
+			it.CreatedAt = now
+			if err := tx.Table("shipment_items").Create(it).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Shipment{}, err
+	}
+	return s, nil
+}
+
+func (r *dbShipmentRepository) MarkDelivered(ctx context.Context, orderID string, id string) (domain.Shipment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ShipmentRepository.MarkDelivered")
+	defer span.End()
+
+	now := time.Now().UTC()
+	res := appdb.Conn(ctx, r.db).Table("shipments").
+		Where("id = ? AND order_id = ? AND status = ?", id, orderID, domain.ShipmentShipped).
+		Updates(map[string]any{"status": domain.ShipmentDelivered, "delivered_at": now, "updated_at": now})
+	if res.Error != nil {
+		span.RecordError(res.Error)
+		return domain.Shipment{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		span.RecordError(domain.ErrStatusConflict)
+		return domain.Shipment{}, domain.ErrStatusConflict
+	}
+	return r.Get(ctx, orderID, id)
+}
+
+func (r *dbShipmentRepository) Get(ctx context.Context, orderID string, id string) (domain.Shipment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ShipmentRepository.Get")
+	defer span.End()
+
+	var s domain.Shipment
+	if err := appdb.Conn(ctx, r.db).Table("shipments").Where("id = ? AND order_id = ?", id, orderID).First(&s).Error; err != nil {
+		span.RecordError(err)
+		return domain.Shipment{}, err
+	}
+	s.Items = []domain.ShipmentItem{}
+	if err := appdb.Conn(ctx, r.db).Table("shipment_items").Where("shipment_id = ?", id).Order("created_at").Find(&s.Items).Error; err != nil {
+		span.RecordError(err)
+		return domain.Shipment{}, err
+	}
+	return s, nil
+}
+
+func (r *dbShipmentRepository) ListByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ShipmentRepository.ListByOrder")
+	defer span.End()
+
+	shipments := []domain.Shipment{}
+	if err := appdb.Conn(ctx, r.db).Table("shipments").Where("order_id = ?", orderID).Order("created_at").Find(&shipments).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(shipments) == 0 {
+		return shipments, nil
+	}
+
+	ids := make([]string, 0, len(shipments))
+	for _, s := range shipments {
+		ids = append(ids, s.ID)
+	}
+	var items []domain.ShipmentItem
+	if err := appdb.Conn(ctx, r.db).Table("shipment_items").Where("shipment_id IN ?", ids).Order("created_at").Find(&items).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	itemsByShipment := make(map[string][]domain.ShipmentItem, len(shipments))
+	for _, it := range items {
+		itemsByShipment[it.ShipmentID] = append(itemsByShipment[it.ShipmentID], it)
+	}
+	for i := range shipments {
+		shipments[i].Items = itemsByShipment[shipments[i].ID]
+	}
+	return shipments, nil
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/internal/order/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type CancelOrderHandler struct {
+	service   command.CancelOrderService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCancelOrderHandler(s command.CancelOrderService, v *validator.Validate, t observability.Tracer) (CancelOrderHandler, error) {
+	return CancelOrderHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Cancel Order
+// @Summary      Cancel order
+// @Description  Cancel an order, restoring inventory and refunding its payment. Owners may cancel before fulfillment; admins anytime.
+// @Tags         Orders
+// @Produce      json
+// @Param        id   path     string  true  "Order ID"
+// @Success      200  {object} domain.Order
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      409  {object} map[string]string "Conflict"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/cancel [post]
+func (h CancelOrderHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.Cancel")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+	role, _ := c.Get(auth.CtxRole).(string)
+
+	order, err := h.service.Cancel(ctx, orderID, userID, role == "admin")
+	if err != nil {
+		span.RecordError(err)
+		var transitionErr domain.TransitionError
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+		case errors.As(err, &transitionErr), errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/internal/order/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateShipmentHandler struct {
+	service   command.CreateShipmentService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCreateShipmentHandler(s command.CreateShipmentService, v *validator.Validate, t observability.Tracer) (CreateShipmentHandler, error) {
+	return CreateShipmentHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type createShipmentRequest struct {
+	Carrier        string `json:"carrier" validate:"required,max=100"`
+	TrackingNumber string `json:"tracking_number" validate:"required,max=100"`
+	// omitted ships everything not shipped yet
+	Items []shipmentItemRequest `json:"items" validate:"dive"`
+}
+
+type shipmentItemRequest struct {
+	OrderItemID string `json:"order_item_id" validate:"required,uuid"`
+	Quantity    int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+// Create Shipment
+// @Summary      Create shipment
+// @Description  Record a parcel of an order. The order moves to partially_shipped or shipped depending on what is left to ship.
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id    path     string                 true  "Order ID"
+// @Param        body  body     createShipmentRequest  true  "Shipment input"
+// @Success      201   {object} domain.Shipment
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      402   {object} map[string]string "Payment Required"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
+// @Failure      422   {object} map[string]string "Unprocessable Entity"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/shipments [post]
+func (h CreateShipmentHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.CreateShipment")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req createShipmentRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	shipment := domain.Shipment{Carrier: req.Carrier, TrackingNumber: req.TrackingNumber}
+	for _, it := range req.Items {
+		shipment.Items = append(shipment.Items, domain.ShipmentItem{OrderItemID: it.OrderItemID, Quantity: it.Quantity})
+	}
+
+	saved, err := h.service.Create(ctx, orderID, shipment)
+	if err != nil {
+		span.RecordError(err)
+		var transitionErr domain.TransitionError
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrInvalidShipment):
+			return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+		case errors.As(err, &transitionErr), errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, saved)
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/internal/order/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type DeliverShipmentHandler struct {
+	service   command.DeliverShipmentService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewDeliverShipmentHandler(s command.DeliverShipmentService, v *validator.Validate, t observability.Tracer) (DeliverShipmentHandler, error) {
+	return DeliverShipmentHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Deliver Shipment
+// @Summary      Mark shipment delivered
+// @Description  Mark a parcel delivered. The order moves to delivered once everything shipped and every shipment is delivered.
+// @Tags         Orders
+// @Produce      json
+// @Param        id           path     string  true  "Order ID"
+// @Param        shipment_id  path     string  true  "Shipment ID"
+// @Success      200          {object} domain.Shipment
+// @Failure      400          {object} map[string]string "Bad Request"
+// @Failure      401          {object} map[string]string "Unauthorized"
+// @Failure      403          {object} map[string]string "Forbidden"
+// @Failure      404          {object} map[string]string "Not Found"
+// @Failure      409          {object} map[string]string "Conflict"
+// @Failure      500          {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/shipments/{shipment_id}/deliver [post]
+func (h DeliverShipmentHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.DeliverShipment")
+	defer span.End()
+
+	orderID := c.Param("id")
+	shipmentID := c.Param("shipment_id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	if err := h.validator.Var(shipmentID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid shipment id"})
+	}
+
+	shipment, err := h.service.Deliver(ctx, orderID, shipmentID)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, shipment)
+}
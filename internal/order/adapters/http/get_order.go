@@ -6,29 +6,40 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 
+	"r2-challenge/internal/order/domain"
 	"r2-challenge/internal/order/services/query"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtqry "r2-challenge/internal/payment/services/query"
 	"r2-challenge/pkg/observability"
 )
 
 type GetOrderHandler struct {
 	service   query.GetByIDService
+	payments  pmtqry.OrderSummaryService
 	validator *validator.Validate
 	tracer    observability.Tracer
 }
 
-func NewGetOrderHandler(s query.GetByIDService, v *validator.Validate, t observability.Tracer) (GetOrderHandler, error) {
-	return GetOrderHandler{service: s, validator: v, tracer: t}, nil
+// orderResponse is the order with a summary of its payments.
+type orderResponse struct {
+	domain.Order
+	Payment pmtdomain.Summary `json:"payment"`
+}
+
+func NewGetOrderHandler(s query.GetByIDService, ps pmtqry.OrderSummaryService, v *validator.Validate, t observability.Tracer) (GetOrderHandler, error) {
+	return GetOrderHandler{service: s, payments: ps, validator: v, tracer: t}, nil
 }
 
 // Get Order by ID
 // @Summary      Get order
-// @Description  Get order by ID
+// @Description  Get order by ID, with a summary of its payments
 // @Tags         Orders
 // @Produce      json
 // @Param        id   path     string  true  "Order ID"
 // @Success      200  {object} map[string]any
 // @Failure      400  {object} map[string]string "Bad Request"
 // @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
 // @Router       /orders/{id} [get]
 func (h GetOrderHandler) Handle(c echo.Context) error {
 	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.GetByID")
@@ -53,5 +64,11 @@ func (h GetOrderHandler) Handle(c echo.Context) error {
 		return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
 	}
 
-	return c.JSON(http.StatusOK, order)
+	summary, err := h.payments.Summary(ctx, order.ID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, orderResponse{Order: order, Payment: summary})
 }
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/order/services/query"
+	pmtqry "r2-challenge/internal/payment/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type ListOrderPaymentsHandler struct {
+	orders    query.GetByIDService
+	service   pmtqry.ListByOrderService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListOrderPaymentsHandler(o query.GetByIDService, s pmtqry.ListByOrderService, v *validator.Validate, t observability.Tracer) (ListOrderPaymentsHandler, error) {
+	return ListOrderPaymentsHandler{orders: o, service: s, validator: v, tracer: t}, nil
+}
+
+// List Order Payments
+// @Summary      List order payments
+// @Description  Payments of an order, oldest first (owner or admin)
+// @Tags         Orders
+// @Produce      json
+// @Param        id   path     string  true  "Order ID"
+// @Success      200  {array}  map[string]any
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/payments [get]
+func (h ListOrderPaymentsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.ListPayments")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	order, err := h.orders.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+
+	role, _ := c.Get(auth.CtxRole).(string)
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if role != "admin" && order.UserID != userID {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+	}
+
+	list, err := h.service.ListByOrder(ctx, order.ID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
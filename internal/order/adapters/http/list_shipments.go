@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/order/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type ListShipmentsHandler struct {
+	orders    query.GetByIDService
+	service   query.ListShipmentsService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListShipmentsHandler(o query.GetByIDService, s query.ListShipmentsService, v *validator.Validate, t observability.Tracer) (ListShipmentsHandler, error) {
+	return ListShipmentsHandler{orders: o, service: s, validator: v, tracer: t}, nil
+}
+
+// List Shipments
+// @Summary      List order shipments
+// @Description  Shipments of an order with their items, oldest first (owner or admin)
+// @Tags         Orders
+// @Produce      json
+// @Param        id   path     string  true  "Order ID"
+// @Success      200  {array}  domain.Shipment
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/shipments [get]
+func (h ListShipmentsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.ListShipments")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	order, err := h.orders.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+
+	role, _ := c.Get(auth.CtxRole).(string)
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if role != "admin" && order.UserID != userID {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+	}
+
+	list, err := h.service.ListByOrder(ctx, order.ID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
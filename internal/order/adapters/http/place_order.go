@@ -1,14 +1,17 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"r2-challenge/internal/order/adapters/shipping"
 	"r2-challenge/internal/order/domain"
 	"r2-challenge/internal/order/services/command"
+	promodomain "r2-challenge/internal/promotion/domain"
 	"r2-challenge/pkg/auth"
 	"r2-challenge/pkg/observability"
 )
@@ -27,8 +30,14 @@ type placeOrderRequest struct {
 	Items []struct {
 		ProductID  string `json:"product_id" validate:"required"`
 		Quantity   int64  `json:"quantity" validate:"required,gt=0"`
-		PriceCents int64  `json:"price_cents" validate:"required,gte=0"`
+		PriceCents int64  `json:"price_cents" validate:"gte=0"` // optional expected unit price
 	} `json:"items" validate:"required,dive"`
+	CouponCode string `json:"coupon_code" validate:"omitempty,max=64"`
+	// the destination is either an address book entry or a full address;
+	// the default address is used when both are empty
+	ShippingAddressID string          `json:"shipping_address_id" validate:"omitempty,uuid"`
+	ShippingAddress   *domain.Address `json:"shipping_address"`
+	ShippingMethod    string          `json:"shipping_method" validate:"omitempty,max=32"`
 }
 
 // Place Order
@@ -39,8 +48,11 @@ type placeOrderRequest struct {
 // @Produce      json
 // @Param        order  body  placeOrderRequest  true  "Order input"
 // @Success      201    {object} domain.Order
-// @Failure      400    {object} map[string]string "Bad Request"
+// @Failure      400    {object} map[string]string "Bad Request or missing shipping address"
 // @Failure      401    {object} map[string]string "Unauthorized"
+// @Failure      402    {object} map[string]string "Payment Required"
+// @Failure      409    {object} map[string]string "Price changed"
+// @Failure      422    {object} map[string]string "Coupon cannot be applied"
 // @Failure      500    {object} map[string]string "Internal Server Error"
 // @Router       /orders [post]
 func (h PlaceOrderHandler) Handle(c echo.Context) error {
@@ -64,21 +76,40 @@ func (h PlaceOrderHandler) Handle(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
 	}
 
+	// prices and total are computed server-side from the catalog
 	items := make([]domain.OrderItem, 0, len(req.Items))
-	var total int64
 	for _, it := range req.Items {
 		if _, err := uuid.Parse(it.ProductID); err != nil {
 			span.RecordError(err)
 			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid product_id"})
 		}
-		items = append(items, domain.OrderItem{ProductID: it.ProductID, Quantity: it.Quantity, PriceCents: it.PriceCents})
-		total += it.PriceCents * it.Quantity
+		items = append(items, domain.OrderItem{ProductID: it.ProductID, Quantity: it.Quantity, ExpectedPriceCents: it.PriceCents})
 	}
 
-	ord := domain.Order{UserID: userID, Items: items, TotalCents: total, Status: "created"}
+	ord := domain.Order{
+		UserID: userID, Items: items, Status: domain.StatusCreated, CouponCode: req.CouponCode,
+		ShippingAddressID: req.ShippingAddressID, ShippingAddress: req.ShippingAddress, ShippingMethod: req.ShippingMethod,
+	}
 	saved, err := h.service.Place(ctx, ord)
 	if err != nil {
 		span.RecordError(err)
+		var priceErr domain.PriceChangedError
+		if errors.As(err, &priceErr) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		if errors.Is(err, domain.ErrPaymentFailed) {
+			return c.JSON(http.StatusPaymentRequired, map[string]string{"error": err.Error()})
+		}
+		if errors.Is(err, domain.ErrPaymentPending) {
+			// the order exists; its payment is settled later
+			return c.JSON(http.StatusAccepted, saved)
+		}
+		if errors.Is(err, promodomain.ErrCouponNotApplicable) {
+			return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+		}
+		if errors.Is(err, domain.ErrInvalidShippingAddress) || errors.Is(err, shipping.ErrUnknownMethod) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
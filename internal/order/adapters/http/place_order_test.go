@@ -56,3 +56,26 @@ func TestPlaceOrderHandler_ReturnsCreatedOrderWithItems(t *testing.T) {
 	require.Len(t, got.Items, 1)
 	require.Equal(t, "p1", got.Items[0].ProductID)
 }
+
+func TestPlaceOrderHandler_PriceChangedReturnsConflict(t *testing.T) {
+	e := echo.New()
+	v, _ := vsetup.Setup()
+	tracer, _ := observability.SetupTracer()
+
+	svc := fakePlaceService{err: domain.PriceChangedError{ProductID: "p1", ExpectedCents: 100, ActualCents: 150}}
+
+	h, err := NewPlaceOrderHandler(svc, v, tracer)
+	require.NoError(t, err)
+
+	body := map[string]any{"items": []map[string]any{{"product_id": "11111111-1111-1111-1111-111111111111", "quantity": 1, "price_cents": 100}}}
+	b, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/v1/orders", bytes.NewReader(b))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set(auth.CtxUserID, "u1")
+
+	err = h.Handle(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusConflict, rec.Code)
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/internal/order/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type RefundOrderHandler struct {
+	service   command.RefundOrderService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewRefundOrderHandler(s command.RefundOrderService, v *validator.Validate, t observability.Tracer) (RefundOrderHandler, error) {
+	return RefundOrderHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type refundOrderRequest struct {
+	// omitted or zero refunds everything still refundable
+	AmountCents int64  `json:"amount_cents" validate:"gte=0"`
+	Reason      string `json:"reason" validate:"max=500"`
+}
+
+// Refund Order
+// @Summary      Refund order
+// @Description  Fully or partially refund an order's captured payments. Total refunds never exceed the captured amount.
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id    path     string              true  "Order ID"
+// @Param        body  body     refundOrderRequest  true  "Refund input"
+// @Success      201   {object} pmtdomain.RefundResult
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/refunds [post]
+func (h RefundOrderHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.Refund")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req refundOrderRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	result, err := h.service.Refund(ctx, orderID, req.AmountCents, req.Reason)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, pmtdomain.ErrNothingToRefund), errors.Is(err, pmtdomain.ErrRefundExceedsCaptured), errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	repo "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/internal/order/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type SearchOrdersHandler struct {
+	service   query.SearchOrdersService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewSearchOrdersHandler(s query.SearchOrdersService, v *validator.Validate, t observability.Tracer) (SearchOrdersHandler, error) {
+	return SearchOrdersHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Search Orders
+// @Summary      Search orders
+// @Description  Search the orders of every user (admin only), one page at a time
+// @Tags         Orders
+// @Produce      json
+// @Param        status           query    string  false  "Comma-separated statuses"
+// @Param        user_id          query    string  false  "User ID"
+// @Param        product_id       query    string  false  "Orders containing this product"
+// @Param        created_from     query    string  false  "Created at or after (RFC3339)"
+// @Param        created_to       query    string  false  "Created before (RFC3339)"
+// @Param        min_total_cents  query    int     false  "Minimum total"
+// @Param        max_total_cents  query    int     false  "Maximum total"
+// @Param        sort             query    string  false  "created_at (default) or total_cents"
+// @Param        order            query    string  false  "desc (default) or asc"
+// @Param        limit            query    int     false  "Page size (default 50, max 200)"
+// @Param        cursor           query    string  false  "next_cursor of the previous page"
+// @Success      200  {object}  map[string]any
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /orders [get]
+func (h SearchOrdersHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "OrderHTTP.Search")
+	defer span.End()
+
+	search, err := parseOrderSearch(c)
+	if err == nil && search.UserID != "" {
+		err = h.validator.Var(search.UserID, "uuid")
+	}
+	if err == nil && search.ProductID != "" {
+		err = h.validator.Var(search.ProductID, "uuid")
+	}
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	page, err := h.service.Search(ctx, search)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, repo.ErrInvalidCursor) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
+
+func parseOrderSearch(c echo.Context) (repo.OrderSearch, error) {
+	search := repo.OrderSearch{
+		UserID:    c.QueryParam("user_id"),
+		ProductID: c.QueryParam("product_id"),
+		Cursor:    c.QueryParam("cursor"),
+		SortBy:    repo.SortByCreatedAt,
+		SortDesc:  true,
+	}
+
+	if s := c.QueryParam("status"); s != "" {
+		for _, st := range strings.Split(s, ",") {
+			st = strings.TrimSpace(st)
+			if !domain.IsKnownStatus(st) {
+				return repo.OrderSearch{}, fmt.Errorf("unknown status %q", st)
+			}
+			search.Statuses = append(search.Statuses, st)
+		}
+	}
+
+	for name, dst := range map[string]**time.Time{"created_from": &search.CreatedFrom, "created_to": &search.CreatedTo} {
+		if s := c.QueryParam(name); s != "" {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return repo.OrderSearch{}, fmt.Errorf("%s must be an RFC3339 time", name)
+			}
+			*dst = &t
+		}
+	}
+	for name, dst := range map[string]**int64{"min_total_cents": &search.MinTotalCents, "max_total_cents": &search.MaxTotalCents} {
+		if s := c.QueryParam(name); s != "" {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return repo.OrderSearch{}, fmt.Errorf("%s must be an integer", name)
+			}
+			*dst = &v
+		}
+	}
+
+	switch s := c.QueryParam("sort"); s {
+	case "":
+	case repo.SortByCreatedAt, repo.SortByTotalCents:
+		search.SortBy = s
+	default:
+		return repo.OrderSearch{}, fmt.Errorf("sort must be %s or %s", repo.SortByCreatedAt, repo.SortByTotalCents)
+	}
+	switch s := c.QueryParam("order"); s {
+	case "", "desc":
+	case "asc":
+		search.SortDesc = false
+	default:
+		return repo.OrderSearch{}, errors.New("order must be asc or desc")
+	}
+
+	if s := c.QueryParam("limit"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil || v <= 0 {
+			return repo.OrderSearch{}, errors.New("limit must be a positive integer")
+		}
+		search.Limit = v
+	}
+
+	return search, nil
+}
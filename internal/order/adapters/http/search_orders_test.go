@@ -0,0 +1,73 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	odb "r2-challenge/internal/order/adapters/db"
+	oq "r2-challenge/internal/order/services/query"
+	"r2-challenge/pkg/observability"
+	vsetup "r2-challenge/pkg/validator"
+)
+
+func TestSearchOrdersHandler_ParsesFilters(t *testing.T) {
+	e := echo.New()
+	v, _ := vsetup.Setup()
+	tracer, _ := observability.SetupTracer()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockSvc := oq.NewMockSearchOrdersService(ctrl)
+
+	handler, err := NewSearchOrdersHandler(mockSvc, v, tracer)
+	require.NoError(t, err)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	minTotal := int64(500)
+	mockSvc.EXPECT().Search(gomock.Any(), odb.OrderSearch{
+		Statuses:      []string{"paid", "shipped"},
+		CreatedFrom:   &from,
+		MinTotalCents: &minTotal,
+		SortBy:        odb.SortByTotalCents,
+		Limit:         20,
+		Cursor:        "abc",
+	}).Return(odb.OrderPage{NextCursor: "def"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders?status=paid,shipped&created_from=2024-01-01T00:00:00Z&min_total_cents=500&sort=total_cents&order=asc&limit=20&cursor=abc", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.Handle(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"next_cursor":"def"`)
+}
+
+func TestSearchOrdersHandler_RejectsBadParams(t *testing.T) {
+	e := echo.New()
+	v, _ := vsetup.Setup()
+	tracer, _ := observability.SetupTracer()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockSvc := oq.NewMockSearchOrdersService(ctrl)
+
+	handler, err := NewSearchOrdersHandler(mockSvc, v, tracer)
+	require.NoError(t, err)
+
+	for _, q := range []string{"status=lost", "created_to=yesterday", "sort=email", "order=up", "limit=0", "user_id=nope"} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/orders?"+q, nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.Handle(e.NewContext(req, rec)))
+		require.Equal(t, http.StatusBadRequest, rec.Code, q)
+	}
+
+	mockSvc.EXPECT().Search(gomock.Any(), gomock.Any()).Return(odb.OrderPage{}, odb.ErrInvalidCursor)
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders?cursor=zzz", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler.Handle(e.NewContext(req, rec)))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -1,11 +1,14 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 
+	"r2-challenge/internal/order/domain"
 	"r2-challenge/internal/order/services/command"
 	"r2-challenge/pkg/observability"
 )
@@ -22,6 +25,7 @@ func NewUpdateStatusHandler(s command.UpdateStatusService, v *validator.Validate
 
 type updateStatusRequest struct {
 	Status string `json:"status" validate:"required"`
+	Reason string `json:"reason" validate:"max=500"`
 }
 
 // Update Order Status
@@ -34,6 +38,9 @@ type updateStatusRequest struct {
 // @Param        body  body  updateStatusRequest  true  "Status input"
 // @Success      200   {object} map[string]any
 // @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      402   {object} map[string]string "Payment Required"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
 // @Failure      500   {object} map[string]string "Internal Server Error"
 // @Router       /orders/{id}/status [put]
 func (h UpdateStatusHandler) Handle(c echo.Context) error {
@@ -57,9 +64,18 @@ func (h UpdateStatusHandler) Handle(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	order, err := h.service.UpdateStatus(ctx, orderID, req.Status)
+	order, err := h.service.UpdateStatus(ctx, orderID, req.Status, req.Reason)
 	if err != nil {
 		span.RecordError(err)
+		var transitionErr domain.TransitionError
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrUnknownStatus), errors.Is(err, domain.ErrStatusNotSettable):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		case errors.As(err, &transitionErr), errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
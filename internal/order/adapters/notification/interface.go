@@ -1,7 +1,11 @@
 package notification
 
-import "context"
+import (
+	"context"
+
+	"r2-challenge/internal/order/domain"
+)
 
 type Sender interface {
-	SendOrderConfirmation(ctx context.Context, toEmail string, orderID string) error
+	SendOrderConfirmation(ctx context.Context, toEmail string, order domain.Order) error
 }
@@ -6,6 +6,7 @@ package notification
 
 import (
 	context "context"
+	domain "r2-challenge/internal/order/domain"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,15 +36,15 @@ func (m *MockSender) EXPECT() *MockSenderMockRecorder {
 }
 
 // SendOrderConfirmation mocks base method.
-func (m *MockSender) SendOrderConfirmation(ctx context.Context, toEmail, orderID string) error {
+func (m *MockSender) SendOrderConfirmation(ctx context.Context, toEmail string, order domain.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendOrderConfirmation", ctx, toEmail, orderID)
+	ret := m.ctrl.Call(m, "SendOrderConfirmation", ctx, toEmail, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendOrderConfirmation indicates an expected call of SendOrderConfirmation.
-func (mr *MockSenderMockRecorder) SendOrderConfirmation(ctx, toEmail, orderID interface{}) *gomock.Call {
+func (mr *MockSenderMockRecorder) SendOrderConfirmation(ctx, toEmail, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderConfirmation", reflect.TypeOf((*MockSender)(nil).SendOrderConfirmation), ctx, toEmail, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderConfirmation", reflect.TypeOf((*MockSender)(nil).SendOrderConfirmation), ctx, toEmail, order)
 }
@@ -1,9 +1,15 @@
 package notification
 
-import "context"
+import (
+	"context"
+
+	"r2-challenge/internal/order/domain"
+)
 
 type noopSender struct{}
 
 func NewNoopSender() Sender { return noopSender{} }
 
-func (noopSender) SendOrderConfirmation(_ context.Context, _ string, _ string) error { return nil }
+func (noopSender) SendOrderConfirmation(_ context.Context, _ string, _ domain.Order) error {
+	return nil
+}
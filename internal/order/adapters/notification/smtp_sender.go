@@ -0,0 +1,185 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"time"
+
+	"r2-challenge/cmd/envs"
+	"r2-challenge/internal/order/domain"
+)
+
+// smtpTimeout bounds a send when the caller's context has no deadline.
+const smtpTimeout = 30 * time.Second
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+type smtpSender struct {
+	cfg  SMTPConfig
+	auth smtp.Auth
+}
+
+// NewSender returns the SMTP sender when SMTP_HOST is configured and the noop
+// sender otherwise, so local runs work without a mail server.
+func NewSender(e envs.Envs) (Sender, error) {
+	if e.SMTPHost == "" {
+		return NewNoopSender(), nil
+	}
+	return NewSMTPSender(SMTPConfig{
+		Host:     e.SMTPHost,
+		Port:     e.SMTPPort,
+		Username: e.SMTPUsername,
+		Password: e.SMTPPassword,
+		From:     e.SMTPFrom,
+	})
+}
+
+func NewSMTPSender(cfg SMTPConfig) (Sender, error) {
+	if cfg.Host == "" || cfg.From == "" {
+		return nil, fmt.Errorf("smtp sender requires host and from address")
+	}
+	s := &smtpSender{cfg: cfg}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s, nil
+}
+
+func (s *smtpSender) SendOrderConfirmation(ctx context.Context, toEmail string, order domain.Order) error {
+	text, html, err := renderOrderConfirmation(order)
+	if err != nil {
+		return err
+	}
+
+	msg, err := buildMessage(s.cfg.From, toEmail, "Your order "+order.ID+" is confirmed", text, html)
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, toEmail, msg)
+}
+
+// send does what smtp.SendMail does over a connection tied to ctx: it gets
+// the context's deadline, or smtpTimeout without one, and is closed when ctx
+// is cancelled, so a stalled server cannot keep the send running.
+func (s *smtpSender) send(ctx context.Context, to string, msg []byte) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.cfg.Host, s.cfg.Port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	if err := s.exchange(conn, to, msg); err != nil {
+		// errors from a connection closed by cancellation say nothing useful
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *smtpSender) exchange(conn net.Conn, to string, msg []byte) error {
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.cfg.Host}); err != nil {
+			return err
+		}
+	}
+	if s.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(s.cfg.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// buildMessage assembles a multipart/alternative message carrying both the
+// plain-text and HTML bodies.
+func buildMessage(from, to, subject, text, html string) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", text},
+		{"text/html; charset=UTF-8", html},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", mw.Boundary())
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,180 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"r2-challenge/internal/order/domain"
+)
+
+// fakeSMTP is a minimal SMTP stand-in that accepts a single message and hands
+// its envelope and data back to the test.
+type fakeSMTP struct {
+	addr string
+	rcpt chan string
+	data chan string
+}
+
+func startFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	f := &fakeSMTP{addr: ln.Addr().String(), rcpt: make(chan string, 1), data: make(chan string, 1)}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM"):
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO"):
+				f.rcpt <- strings.TrimSpace(line)
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 end with .")
+				var b strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					b.WriteString(l)
+				}
+				f.data <- b.String()
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+	return f
+}
+
+func TestSMTPSender_SendOrderConfirmation(t *testing.T) {
+	srv := startFakeSMTP(t)
+	host, port, _ := net.SplitHostPort(srv.addr)
+
+	s, err := NewSMTPSender(SMTPConfig{Host: host, Port: port, From: "shop@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	order := domain.Order{
+		ID:             "o1",
+		Status:         domain.StatusCreated,
+		SubtotalCents:  2550,
+		TaxCents:       204,
+		ShippingCents:  500,
+		ShippingMethod: "flat",
+		ShippingAddress: &domain.Address{
+			Name: "Jane Doe", Line1: "1 Main St", City: "Springfield", Region: "IL", PostalCode: "62701", Country: "US",
+		},
+		TotalCents: 3254,
+		Items: []domain.OrderItem{
+			{ProductID: "p1", ProductName: "Espresso Machine", Quantity: 2, PriceCents: 1000, TaxRateBps: 800, TaxCents: 160},
+			{ProductID: "p2", Quantity: 1, PriceCents: 550, TaxRateBps: 800, TaxCents: 44},
+		},
+	}
+	if err := s.SendOrderConfirmation(context.Background(), "jane@example.com", order); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if rcpt := <-srv.rcpt; !strings.Contains(rcpt, "jane@example.com") {
+		t.Fatalf("unexpected recipient: %s", rcpt)
+	}
+	data := <-srv.data
+	for _, want := range []string{
+		"Subject: Your order o1 is confirmed",
+		"multipart/alternative",
+		"text/plain",
+		"text/html",
+		"Espresso Machine",
+		"p2", // items placed before names were recorded show their id
+		"$20.00",
+		"Subtotal: $25.50",
+		"Tax: $2.04",
+		"8%",
+		"Shipping (flat): $5.00",
+		"1 Main St",
+		"Springfield, IL 62701",
+		"$32.54",
+	} {
+		if !strings.Contains(data, want) {
+			t.Fatalf("message missing %q:\n%s", want, data)
+		}
+	}
+}
+
+func TestSMTPSender_CancelClosesStalledConnection(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	// the server accepts but never greets; closed fires once the client hangs up
+	closed := make(chan struct{})
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(io.Discard, conn)
+		close(closed)
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	s, err := NewSMTPSender(SMTPConfig{Host: host, Port: port, From: "shop@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	if err := s.SendOrderConfirmation(ctx, "jane@example.com", domain.Order{ID: "o1"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("connection still open after cancel")
+	}
+}
+
+func TestNewSMTPSender_RequiresHostAndFrom(t *testing.T) {
+	if _, err := NewSMTPSender(SMTPConfig{Host: "localhost"}); err == nil {
+		t.Fatalf("expected error without from address")
+	}
+}
@@ -0,0 +1,61 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"r2-challenge/internal/order/domain"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templateFuncs = map[string]any{
+	"money":   formatCents,
+	"percent": formatBps,
+	"lineTotal": func(it domain.OrderItem) int64 {
+		return it.PriceCents * it.Quantity
+	},
+}
+
+var confirmationText = texttemplate.Must(
+	texttemplate.New("order_confirmation.txt.tmpl").Funcs(templateFuncs).
+		ParseFS(templateFS, "templates/order_confirmation.txt.tmpl"),
+)
+
+var confirmationHTML = htmltemplate.Must(
+	htmltemplate.New("order_confirmation.html.tmpl").Funcs(templateFuncs).
+		ParseFS(templateFS, "templates/order_confirmation.html.tmpl"),
+)
+
+// renderOrderConfirmation renders the plain-text and HTML bodies of the
+// order confirmation email.
+func renderOrderConfirmation(order domain.Order) (text string, html string, err error) {
+	var tb, hb bytes.Buffer
+	if err := confirmationText.Execute(&tb, order); err != nil {
+		return "", "", err
+	}
+	if err := confirmationHTML.Execute(&hb, order); err != nil {
+		return "", "", err
+	}
+	return tb.String(), hb.String(), nil
+}
+
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
+
+// formatBps renders a rate in basis points as a percentage, e.g. 725 as "7.25%".
+func formatBps(bps int64) string {
+	s := fmt.Sprintf("%d.%02d", bps/100, bps%100)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return s + "%"
+}
@@ -0,0 +1,226 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"r2-challenge/cmd/envs"
+	pmtdomain "r2-challenge/internal/payment/domain"
+)
+
+// Payment providers selected with PAYMENT_PROVIDER.
+const (
+	ProviderNoop    = "noop"
+	ProviderGateway = "gateway"
+)
+
+// ErrDeclined is returned when the gateway refuses an operation; it is never retried.
+var ErrDeclined = errors.New("payment declined")
+
+// ErrUnexpectedResponse is returned when the gateway answers a report request
+// with an error status; unlike ErrDeclined it says nothing about a payment.
+var ErrUnexpectedResponse = errors.New("unexpected payment gateway response")
+
+type GatewayConfig struct {
+	BaseURL string
+	APIKey  string
+	// Timeout bounds a single HTTP attempt.
+	Timeout time.Duration
+	// MaxRetries is how many times a failed attempt is repeated on network
+	// errors, 429 and 5xx responses.
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+type gatewayProcessor struct {
+	cfg    GatewayConfig
+	client *http.Client
+}
+
+// NewProcessor returns the processor named by PAYMENT_PROVIDER; the noop
+// processor is used when it is empty so local runs need no gateway.
+func NewProcessor(e envs.Envs) (Processor, error) {
+	switch e.PaymentProvider {
+	case "", ProviderNoop:
+		return NewNoopProcessor(), nil
+	case ProviderGateway:
+		timeout, err := time.ParseDuration(e.PaymentGatewayTimeout)
+		if err != nil || timeout <= 0 {
+			timeout = 10 * time.Second
+		}
+		backoff, err := time.ParseDuration(e.PaymentGatewayRetryBackoff)
+		if err != nil || backoff <= 0 {
+			backoff = 200 * time.Millisecond
+		}
+		return NewGatewayProcessor(GatewayConfig{
+			BaseURL:      e.PaymentGatewayURL,
+			APIKey:       e.PaymentGatewayAPIKey,
+			Timeout:      timeout,
+			MaxRetries:   e.PaymentGatewayMaxRetries,
+			RetryBackoff: backoff,
+		})
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", e.PaymentProvider)
+	}
+}
+
+func NewGatewayProcessor(cfg GatewayConfig) (Processor, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("payment gateway requires a base url")
+	}
+	if _, err := url.ParseRequestURI(cfg.BaseURL); err != nil {
+		return nil, fmt.Errorf("invalid payment gateway url: %w", err)
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	return &gatewayProcessor{cfg: cfg, client: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+func (g *gatewayProcessor) Name() string { return ProviderGateway }
+
+func (g *gatewayProcessor) Charge(ctx context.Context, idempotencyKey string, userID string, amountCents int64) (string, error) {
+	return g.call(ctx, "/v1/charges", idempotencyKey, map[string]any{"user_id": userID, "amount_cents": amountCents})
+}
+
+func (g *gatewayProcessor) Refund(ctx context.Context, idempotencyKey string, receiptID string, amountCents int64) error {
+	_, err := g.call(ctx, "/v1/refunds", idempotencyKey, map[string]any{"charge_id": receiptID, "amount_cents": amountCents})
+	return err
+}
+
+func (g *gatewayProcessor) Authorize(ctx context.Context, idempotencyKey string, userID string, amountCents int64) (string, error) {
+	return g.call(ctx, "/v1/authorizations", idempotencyKey, map[string]any{"user_id": userID, "amount_cents": amountCents})
+}
+
+func (g *gatewayProcessor) Capture(ctx context.Context, authorizationID string, amountCents int64) (string, error) {
+	// an authorization is captured once, so its id names the capture
+	return g.call(ctx, "/v1/authorizations/"+url.PathEscape(authorizationID)+"/capture", "capture:"+authorizationID, map[string]any{"amount_cents": amountCents})
+}
+
+func (g *gatewayProcessor) Void(ctx context.Context, authorizationID string) error {
+	_, err := g.call(ctx, "/v1/authorizations/"+url.PathEscape(authorizationID)+"/void", "void:"+authorizationID, map[string]any{})
+	return err
+}
+
+func (g *gatewayProcessor) Settlements(ctx context.Context, from time.Time, to time.Time) ([]pmtdomain.Settlement, error) {
+	q := url.Values{}
+	q.Set("from", from.UTC().Format(time.RFC3339))
+	q.Set("to", to.UTC().Format(time.RFC3339))
+
+	var out gatewayResponse
+	if err := g.do(ctx, http.MethodGet, "/v1/settlements?"+q.Encode(), "", nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Settlements, nil
+}
+
+// gatewayResponse is the body of every gateway reply; ID is the charge,
+// refund or authorization that was created and Settlements answers a
+// settlement report request.
+type gatewayResponse struct {
+	ID          string                 `json:"id"`
+	Settlements []pmtdomain.Settlement `json:"settlements"`
+	Error       string                 `json:"error"`
+}
+
+// call POSTs body to path under idempotencyKey and returns the id of the
+// created object.
+func (g *gatewayProcessor) call(ctx context.Context, path string, idempotencyKey string, body any) (string, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	var out gatewayResponse
+	if err := g.do(ctx, http.MethodPost, path, idempotencyKey, payload, &out); err != nil {
+		return "", err
+	}
+	if out.ID == "" {
+		return "", fmt.Errorf("payment gateway %s: response without id", path)
+	}
+	return out.ID, nil
+}
+
+// do sends the request, retrying transient failures, and decodes a
+// successful reply into out. Every attempt carries the caller's
+// Idempotency-Key, and so does a later call for the same payment, so neither
+// a retry after a lost response nor a repeated operation charges twice. Reads
+// go without a key.
+func (g *gatewayProcessor) do(ctx context.Context, method string, path string, key string, payload []byte, out *gatewayResponse) error {
+	var lastErr error
+	for attempt := 0; attempt <= g.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			delay := g.cfg.RetryBackoff << (attempt - 1)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		retry, err := g.attempt(ctx, method, path, key, payload, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+
+	return lastErr
+}
+
+// attempt performs one request and reports whether a failure may be retried.
+func (g *gatewayProcessor) attempt(ctx context.Context, method string, path string, key string, payload []byte, out *gatewayResponse) (bool, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, g.cfg.BaseURL+path, body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	if g.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+g.cfg.APIKey)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		// the caller giving up is final; transport failures are retried
+		return ctx.Err() == nil, fmt.Errorf("payment gateway %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	*out = gatewayResponse{}
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 8<<20))
+	_ = json.Unmarshal(raw, out)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("payment gateway %s: status %d", path, resp.StatusCode)
+	case method != http.MethodPost:
+		return false, fmt.Errorf("%w: %s: status %d", ErrUnexpectedResponse, path, resp.StatusCode)
+	default:
+		reason := out.Error
+		if reason == "" {
+			reason = http.StatusText(resp.StatusCode)
+		}
+		return false, fmt.Errorf("%w: %s", ErrDeclined, reason)
+	}
+}
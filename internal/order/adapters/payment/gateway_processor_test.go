@@ -0,0 +1,233 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"r2-challenge/cmd/envs"
+	pmtdomain "r2-challenge/internal/payment/domain"
+)
+
+// fakeGateway is an in-process stand-in for the REST payment gateway. It
+// answers the first `failures` requests with `failStatus` and records every
+// request it receives.
+type fakeGateway struct {
+	mu         sync.Mutex
+	failures   int
+	failStatus int
+	requests   []fakeRequest
+}
+
+type fakeRequest struct {
+	Path           string
+	Authorization  string
+	IdempotencyKey string
+	Body           map[string]any
+}
+
+func startFakeGateway(t *testing.T, g *fakeGateway) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		g.mu.Lock()
+		g.requests = append(g.requests, fakeRequest{
+			Path:           r.URL.Path,
+			Authorization:  r.Header.Get("Authorization"),
+			IdempotencyKey: r.Header.Get("Idempotency-Key"),
+			Body:           body,
+		})
+		fail := g.failures > 0
+		if fail {
+			g.failures--
+		}
+		g.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if fail {
+			w.WriteHeader(g.failStatus)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "card declined"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "gw_" + r.Header.Get("Idempotency-Key")})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestGateway(t *testing.T, url string) Processor {
+	t.Helper()
+	p, err := NewGatewayProcessor(GatewayConfig{BaseURL: url, APIKey: "sk_test", Timeout: time.Second, MaxRetries: 2, RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("new gateway: %v", err)
+	}
+	return p
+}
+
+func TestGatewayProcessor_Charge(t *testing.T) {
+	g := &fakeGateway{}
+	srv := startFakeGateway(t, g)
+	p := newTestGateway(t, srv.URL)
+
+	receiptID, err := p.Charge(context.Background(), "o1", "u1", 1500)
+	if err != nil {
+		t.Fatalf("charge: %v", err)
+	}
+	if receiptID == "" {
+		t.Fatalf("expected receipt id")
+	}
+
+	if len(g.requests) != 1 {
+		t.Fatalf("expected one request, got %d", len(g.requests))
+	}
+	req := g.requests[0]
+	if req.Path != "/v1/charges" || req.Authorization != "Bearer sk_test" || req.IdempotencyKey != "o1" {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	if req.Body["user_id"] != "u1" || req.Body["amount_cents"] != float64(1500) {
+		t.Fatalf("unexpected body: %v", req.Body)
+	}
+}
+
+func TestGatewayProcessor_RetriesWithSameIdempotencyKey(t *testing.T) {
+	g := &fakeGateway{failures: 2, failStatus: http.StatusServiceUnavailable}
+	srv := startFakeGateway(t, g)
+	p := newTestGateway(t, srv.URL)
+
+	if _, err := p.Capture(context.Background(), "auth_1", 1500); err != nil {
+		t.Fatalf("capture: %v", err)
+	}
+
+	if len(g.requests) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(g.requests))
+	}
+	for _, req := range g.requests {
+		if req.IdempotencyKey != g.requests[0].IdempotencyKey {
+			t.Fatalf("idempotency key changed between retries")
+		}
+		if req.Path != "/v1/authorizations/auth_1/capture" {
+			t.Fatalf("unexpected path: %s", req.Path)
+		}
+	}
+}
+
+func TestGatewayProcessor_GivesUpAfterMaxRetries(t *testing.T) {
+	g := &fakeGateway{failures: 10, failStatus: http.StatusBadGateway}
+	srv := startFakeGateway(t, g)
+	p := newTestGateway(t, srv.URL)
+
+	if err := p.Refund(context.Background(), "rf_1", "ch_1", 500); err == nil {
+		t.Fatalf("expected error")
+	}
+	if len(g.requests) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(g.requests))
+	}
+}
+
+func TestGatewayProcessor_DeclineIsNotRetried(t *testing.T) {
+	g := &fakeGateway{failures: 1, failStatus: http.StatusPaymentRequired}
+	srv := startFakeGateway(t, g)
+	p := newTestGateway(t, srv.URL)
+
+	_, err := p.Authorize(context.Background(), "o1", "u1", 1500)
+	if !errors.Is(err, ErrDeclined) {
+		t.Fatalf("expected ErrDeclined, got %v", err)
+	}
+	if len(g.requests) != 1 {
+		t.Fatalf("expected a single attempt, got %d", len(g.requests))
+	}
+}
+
+func TestGatewayProcessor_CallsCarryTheCallersKey(t *testing.T) {
+	g := &fakeGateway{}
+	srv := startFakeGateway(t, g)
+	p := newTestGateway(t, srv.URL)
+
+	// a repeated charge of one order reuses its key; another order gets its own
+	_, _ = p.Charge(context.Background(), "o1", "u1", 100)
+	_, _ = p.Charge(context.Background(), "o1", "u1", 100)
+	_, _ = p.Charge(context.Background(), "o2", "u1", 100)
+	_ = p.Refund(context.Background(), "rf_1", "ch_1", 100)
+
+	keys := []string{}
+	for _, req := range g.requests {
+		keys = append(keys, req.IdempotencyKey)
+	}
+	if len(keys) != 4 || keys[0] != "o1" || keys[1] != "o1" || keys[2] != "o2" || keys[3] != "rf_1" {
+		t.Fatalf("unexpected idempotency keys: %v", keys)
+	}
+}
+
+func TestGatewayProcessor_Settlements(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/settlements" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.RawQuery
+		_ = json.NewEncoder(w).Encode(map[string]any{"settlements": []pmtdomain.Settlement{
+			{ReceiptID: "ch_1", AmountCents: 1500, Status: pmtdomain.StatusCaptured},
+		}})
+	}))
+	t.Cleanup(srv.Close)
+	p := newTestGateway(t, srv.URL)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	settlements, err := p.Settlements(context.Background(), from, from.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("settlements: %v", err)
+	}
+	if len(settlements) != 1 || settlements[0].ReceiptID != "ch_1" || settlements[0].AmountCents != 1500 {
+		t.Fatalf("unexpected settlements: %+v", settlements)
+	}
+	if query != "from=2024-01-01T00%3A00%3A00Z&to=2024-01-02T00%3A00%3A00Z" {
+		t.Fatalf("unexpected query: %s", query)
+	}
+}
+
+func TestGatewayProcessor_SettlementsErrorIsNotADecline(t *testing.T) {
+	g := &fakeGateway{failures: 1, failStatus: http.StatusForbidden}
+	srv := startFakeGateway(t, g)
+	p := newTestGateway(t, srv.URL)
+
+	_, err := p.Settlements(context.Background(), time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, ErrUnexpectedResponse) || errors.Is(err, ErrDeclined) {
+		t.Fatalf("expected ErrUnexpectedResponse, got %v", err)
+	}
+	if len(g.requests) != 1 || g.requests[0].IdempotencyKey != "" {
+		t.Fatalf("expected a single request without idempotency key: %+v", g.requests)
+	}
+}
+
+func TestNoopProcessor_HasNoSettlementReport(t *testing.T) {
+	if _, err := NewNoopProcessor().Settlements(context.Background(), time.Now(), time.Now()); !errors.Is(err, pmtdomain.ErrNoSettlementReport) {
+		t.Fatalf("expected ErrNoSettlementReport, got %v", err)
+	}
+}
+
+func TestNewProcessor_SelectsProvider(t *testing.T) {
+	p, err := NewProcessor(envs.Envs{})
+	if err != nil || p.Name() != "mock" {
+		t.Fatalf("expected noop processor, got %v, %v", p, err)
+	}
+
+	p, err = NewProcessor(envs.Envs{PaymentProvider: ProviderGateway, PaymentGatewayURL: "http://127.0.0.1:1"})
+	if err != nil || p.Name() != ProviderGateway {
+		t.Fatalf("expected gateway processor, got %v, %v", p, err)
+	}
+
+	if _, err := NewProcessor(envs.Envs{PaymentProvider: ProviderGateway}); err == nil {
+		t.Fatalf("expected error without gateway url")
+	}
+	if _, err := NewProcessor(envs.Envs{PaymentProvider: "acme"}); err == nil {
+		t.Fatalf("expected error for unknown provider")
+	}
+}
@@ -1,7 +1,33 @@
 package payment
 
-import "context"
+import (
+	"context"
+	"time"
 
+	pmtdomain "r2-challenge/internal/payment/domain"
+)
+
+// Processor talks to a payment provider. A refusal is reported as an error
+// wrapping ErrDeclined; any other error leaves the outcome unknown, as the
+// provider may have acted on the request before it failed.
 type Processor interface {
-	Charge(ctx context.Context, userID string, amountCents int64) (receiptID string, err error)
+	// Name identifies the provider on recorded payments.
+	Name() string
+	// Charge collects amountCents from the customer. idempotencyKey names the
+	// charge, the order id, so a repeated call returns the first charge
+	// instead of collecting again.
+	Charge(ctx context.Context, idempotencyKey string, userID string, amountCents int64) (receiptID string, err error)
+	// Refund returns amountCents of a charge; idempotencyKey is the id of the
+	// recorded refund, so a repeated call pays back only once.
+	Refund(ctx context.Context, idempotencyKey string, receiptID string, amountCents int64) error
+	// Authorize holds amountCents on the customer's payment method without
+	// collecting it; idempotencyKey is the order id, as for Charge.
+	Authorize(ctx context.Context, idempotencyKey string, userID string, amountCents int64) (authorizationID string, err error)
+	// Capture collects a previously authorized amount.
+	Capture(ctx context.Context, authorizationID string, amountCents int64) (receiptID string, err error)
+	// Void releases an authorization that will not be captured.
+	Void(ctx context.Context, authorizationID string) error
+	// Settlements reports what the provider settled for payments created in
+	// [from, to); pmtdomain.ErrNoSettlementReport when it cannot.
+	Settlements(ctx context.Context, from time.Time, to time.Time) ([]pmtdomain.Settlement, error)
 }
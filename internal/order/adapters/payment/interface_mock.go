@@ -6,7 +6,9 @@ package payment
 
 import (
 	context "context"
+	domain "r2-challenge/internal/payment/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,17 +36,104 @@ func (m *MockProcessor) EXPECT() *MockProcessorMockRecorder {
 	return m.recorder
 }
 
+// Authorize mocks base method.
+func (m *MockProcessor) Authorize(ctx context.Context, idempotencyKey, userID string, amountCents int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, idempotencyKey, userID, amountCents)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockProcessorMockRecorder) Authorize(ctx, idempotencyKey, userID, amountCents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockProcessor)(nil).Authorize), ctx, idempotencyKey, userID, amountCents)
+}
+
+// Capture mocks base method.
+func (m *MockProcessor) Capture(ctx context.Context, authorizationID string, amountCents int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, authorizationID, amountCents)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockProcessorMockRecorder) Capture(ctx, authorizationID, amountCents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockProcessor)(nil).Capture), ctx, authorizationID, amountCents)
+}
+
 // Charge mocks base method.
-func (m *MockProcessor) Charge(ctx context.Context, userID string, amountCents int64) (string, error) {
+func (m *MockProcessor) Charge(ctx context.Context, idempotencyKey, userID string, amountCents int64) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Charge", ctx, userID, amountCents)
+	ret := m.ctrl.Call(m, "Charge", ctx, idempotencyKey, userID, amountCents)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Charge indicates an expected call of Charge.
-func (mr *MockProcessorMockRecorder) Charge(ctx, userID, amountCents interface{}) *gomock.Call {
+func (mr *MockProcessorMockRecorder) Charge(ctx, idempotencyKey, userID, amountCents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charge", reflect.TypeOf((*MockProcessor)(nil).Charge), ctx, idempotencyKey, userID, amountCents)
+}
+
+// Name mocks base method.
+func (m *MockProcessor) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockProcessorMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockProcessor)(nil).Name))
+}
+
+// Refund mocks base method.
+func (m *MockProcessor) Refund(ctx context.Context, idempotencyKey, receiptID string, amountCents int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, idempotencyKey, receiptID, amountCents)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockProcessorMockRecorder) Refund(ctx, idempotencyKey, receiptID, amountCents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockProcessor)(nil).Refund), ctx, idempotencyKey, receiptID, amountCents)
+}
+
+// Settlements mocks base method.
+func (m *MockProcessor) Settlements(ctx context.Context, from, to time.Time) ([]domain.Settlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settlements", ctx, from, to)
+	ret0, _ := ret[0].([]domain.Settlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Settlements indicates an expected call of Settlements.
+func (mr *MockProcessorMockRecorder) Settlements(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settlements", reflect.TypeOf((*MockProcessor)(nil).Settlements), ctx, from, to)
+}
+
+// Void mocks base method.
+func (m *MockProcessor) Void(ctx context.Context, authorizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, authorizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockProcessorMockRecorder) Void(ctx, authorizationID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charge", reflect.TypeOf((*MockProcessor)(nil).Charge), ctx, userID, amountCents)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockProcessor)(nil).Void), ctx, authorizationID)
 }
@@ -1,11 +1,38 @@
 package payment
 
-import "context"
+import (
+	"context"
+	"time"
+
+	pmtdomain "r2-challenge/internal/payment/domain"
+)
 
 type noopProcessor struct{}
 
 func NewNoopProcessor() Processor { return noopProcessor{} }
 
-func (noopProcessor) Charge(_ context.Context, _ string, _ int64) (string, error) {
+func (noopProcessor) Name() string { return "mock" }
+
+func (noopProcessor) Charge(_ context.Context, _ string, _ string, _ int64) (string, error) {
 	return "noop-receipt", nil
 }
+
+func (noopProcessor) Refund(_ context.Context, _ string, _ string, _ int64) error {
+	return nil
+}
+
+func (noopProcessor) Authorize(_ context.Context, _ string, _ string, _ int64) (string, error) {
+	return "noop-authorization", nil
+}
+
+func (noopProcessor) Capture(_ context.Context, _ string, _ int64) (string, error) {
+	return "noop-receipt", nil
+}
+
+func (noopProcessor) Void(_ context.Context, _ string) error {
+	return nil
+}
+
+func (noopProcessor) Settlements(_ context.Context, _ time.Time, _ time.Time) ([]pmtdomain.Settlement, error) {
+	return nil, pmtdomain.ErrNoSettlementReport
+}
@@ -0,0 +1,20 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+
+	"r2-challenge/internal/order/domain"
+)
+
+// ErrUnknownMethod is returned when an order asks for a shipping method that is not offered.
+var ErrUnknownMethod = errors.New("unknown shipping method")
+
+type Calculator interface {
+	// Method resolves the shipping method a customer chose; an empty name
+	// picks the default one.
+	Method(name string) (string, error)
+	// Cost prices shipping the order with its ShippingMethod. Items carry
+	// their catalog price, discount and weight.
+	Cost(ctx context.Context, order domain.Order) (int64, error)
+}
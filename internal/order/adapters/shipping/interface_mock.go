@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/adapters/shipping/interface.go
+
+// Package shipping is a generated GoMock package.
+package shipping
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCalculator is a mock of Calculator interface.
+type MockCalculator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalculatorMockRecorder
+}
+
+// MockCalculatorMockRecorder is the mock recorder for MockCalculator.
+type MockCalculatorMockRecorder struct {
+	mock *MockCalculator
+}
+
+// NewMockCalculator creates a new mock instance.
+func NewMockCalculator(ctrl *gomock.Controller) *MockCalculator {
+	mock := &MockCalculator{ctrl: ctrl}
+	mock.recorder = &MockCalculatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalculator) EXPECT() *MockCalculatorMockRecorder {
+	return m.recorder
+}
+
+// Cost mocks base method.
+func (m *MockCalculator) Cost(ctx context.Context, order domain.Order) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cost", ctx, order)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cost indicates an expected call of Cost.
+func (mr *MockCalculatorMockRecorder) Cost(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cost", reflect.TypeOf((*MockCalculator)(nil).Cost), ctx, order)
+}
+
+// Method mocks base method.
+func (m *MockCalculator) Method(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Method", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Method indicates an expected call of Method.
+func (mr *MockCalculatorMockRecorder) Method(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Method", reflect.TypeOf((*MockCalculator)(nil).Method), name)
+}
@@ -0,0 +1,118 @@
+package shipping
+
+import (
+	"context"
+	"fmt"
+
+	"r2-challenge/cmd/envs"
+	"r2-challenge/internal/order/domain"
+)
+
+// Shipping methods offered by NewCalculator.
+const (
+	MethodFlat   = "flat"
+	MethodWeight = "weight"
+)
+
+// Rate prices shipping an order with one method.
+type Rate interface {
+	Cost(order domain.Order) int64
+}
+
+type flatRate struct {
+	cents int64
+}
+
+// Flat charges the same amount for every order.
+func Flat(cents int64) Rate {
+	return flatRate{cents: cents}
+}
+
+func (r flatRate) Cost(domain.Order) int64 { return r.cents }
+
+type weightRate struct {
+	baseCents  int64
+	centsPerKg int64
+}
+
+// ByWeight charges baseCents plus centsPerKg for every started kilogram of
+// the order's items.
+func ByWeight(baseCents int64, centsPerKg int64) Rate {
+	return weightRate{baseCents: baseCents, centsPerKg: centsPerKg}
+}
+
+func (r weightRate) Cost(order domain.Order) int64 {
+	var grams int64
+	for _, it := range order.Items {
+		grams += it.WeightGrams * it.Quantity
+	}
+	return r.baseCents + (grams+999)/1000*r.centsPerKg
+}
+
+type freeOverRate struct {
+	thresholdCents int64
+	rate           Rate
+}
+
+// FreeOver ships for free once the items cost thresholdCents after
+// discounts, and prices with rate below it.
+func FreeOver(thresholdCents int64, rate Rate) Rate {
+	return freeOverRate{thresholdCents: thresholdCents, rate: rate}
+}
+
+func (r freeOverRate) Cost(order domain.Order) int64 {
+	var spent int64
+	for _, it := range order.Items {
+		spent += it.PriceCents*it.Quantity - it.DiscountCents
+	}
+	if spent >= r.thresholdCents {
+		return 0
+	}
+	return r.rate.Cost(order)
+}
+
+type methodTable struct {
+	rates         map[string]Rate
+	defaultMethod string
+}
+
+// NewCalculator offers the flat and weight methods priced by the SHIPPING_*
+// settings; with SHIPPING_FREE_OVER_CENTS set, both ship for free above it.
+func NewCalculator(e envs.Envs) (Calculator, error) {
+	rates := map[string]Rate{
+		MethodFlat:   Flat(int64(e.ShippingFlatCents)),
+		MethodWeight: ByWeight(int64(e.ShippingWeightBaseCents), int64(e.ShippingWeightCentsPerKg)),
+	}
+	if e.ShippingFreeOverCents > 0 {
+		for name, rate := range rates {
+			rates[name] = FreeOver(int64(e.ShippingFreeOverCents), rate)
+		}
+	}
+	return NewMethodTable(rates, e.ShippingDefaultMethod)
+}
+
+// NewMethodTable offers one method per rate; defaultMethod must be one of them.
+func NewMethodTable(rates map[string]Rate, defaultMethod string) (Calculator, error) {
+	if _, ok := rates[defaultMethod]; !ok {
+		return nil, fmt.Errorf("%w: default %q", ErrUnknownMethod, defaultMethod)
+	}
+	return &methodTable{rates: rates, defaultMethod: defaultMethod}, nil
+}
+
+func (t *methodTable) Method(name string) (string, error) {
+	if name == "" {
+		return t.defaultMethod, nil
+	}
+	if _, ok := t.rates[name]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownMethod, name)
+	}
+	return name, nil
+}
+
+func (t *methodTable) Cost(_ context.Context, order domain.Order) (int64, error) {
+	rate, ok := t.rates[order.ShippingMethod]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownMethod, order.ShippingMethod)
+	}
+	return rate.Cost(order), nil
+}
@@ -0,0 +1,60 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"r2-challenge/cmd/envs"
+	"r2-challenge/internal/order/domain"
+)
+
+func TestRates(t *testing.T) {
+	order := domain.Order{Items: []domain.OrderItem{
+		{Quantity: 2, PriceCents: 1000, DiscountCents: 500, WeightGrams: 600}, // 1.2kg
+		{Quantity: 1, PriceCents: 2000, WeightGrams: 300},                     // 0.3kg
+	}}
+
+	cases := []struct {
+		name string
+		rate Rate
+		want int64
+	}{
+		{"flat", Flat(500), 500},
+		{"started kilograms", ByWeight(300, 200), 300 + 2*200},
+		{"below threshold", FreeOver(4000, Flat(500)), 500},
+		{"threshold after discounts", FreeOver(3500, Flat(500)), 0},
+	}
+	for _, tc := range cases {
+		if got := tc.rate.Cost(order); got != tc.want {
+			t.Fatalf("%s: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestMethodTable(t *testing.T) {
+	calc, err := NewCalculator(envs.Envs{ShippingDefaultMethod: MethodFlat, ShippingFlatCents: 500, ShippingWeightBaseCents: 100, ShippingWeightCentsPerKg: 100, ShippingFreeOverCents: 10000})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if m, err := calc.Method(""); err != nil || m != MethodFlat {
+		t.Fatalf("expected the default method, got %q (%v)", m, err)
+	}
+	if _, err := calc.Method("drone"); !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("expected ErrUnknownMethod, got %v", err)
+	}
+
+	order := domain.Order{ShippingMethod: MethodWeight, Items: []domain.OrderItem{{Quantity: 1, PriceCents: 1000, WeightGrams: 2500}}}
+	if cost, err := calc.Cost(context.Background(), order); err != nil || cost != 400 {
+		t.Fatalf("unexpected weight cost %d (%v)", cost, err)
+	}
+	order.Items[0].PriceCents = 10000
+	if cost, _ := calc.Cost(context.Background(), order); cost != 0 {
+		t.Fatalf("expected free shipping over the threshold, got %d", cost)
+	}
+
+	if _, err := NewMethodTable(map[string]Rate{MethodFlat: Flat(0)}, "express"); !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("expected an unknown default method to fail, got %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package tax
+
+import (
+	"context"
+
+	"r2-challenge/internal/order/domain"
+)
+
+type Calculator interface {
+	// Calculate returns the tax of each item of the order, in order, at the
+	// order's TaxCountry and TaxRegion. Items are taxed on their subtotal
+	// minus their discount.
+	Calculate(ctx context.Context, order domain.Order) ([]Tax, error)
+}
+
+// Tax is the tax charged on one order item.
+type Tax struct {
+	RateBps int64
+	Cents   int64
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/adapters/tax/interface.go
+
+// Package tax is a generated GoMock package.
+package tax
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCalculator is a mock of Calculator interface.
+type MockCalculator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalculatorMockRecorder
+}
+
+// MockCalculatorMockRecorder is the mock recorder for MockCalculator.
+type MockCalculatorMockRecorder struct {
+	mock *MockCalculator
+}
+
+// NewMockCalculator creates a new mock instance.
+func NewMockCalculator(ctrl *gomock.Controller) *MockCalculator {
+	mock := &MockCalculator{ctrl: ctrl}
+	mock.recorder = &MockCalculatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalculator) EXPECT() *MockCalculatorMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockCalculator) Calculate(ctx context.Context, order domain.Order) ([]Tax, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, order)
+	ret0, _ := ret[0].([]Tax)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockCalculatorMockRecorder) Calculate(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockCalculator)(nil).Calculate), ctx, order)
+}
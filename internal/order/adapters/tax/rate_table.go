@@ -0,0 +1,125 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"r2-challenge/cmd/envs"
+	"r2-challenge/internal/order/domain"
+)
+
+// AnyCountry is the country of a rate that applies wherever no country rate does.
+const AnyCountry = "*"
+
+// Rate is the tax rate, in basis points, of one location and product
+// category. Empty Region or Category match any region or category.
+type Rate struct {
+	Country  string
+	Region   string
+	Category string
+	Bps      int64
+}
+
+type rateKey struct {
+	country  string
+	region   string
+	category string
+}
+
+type rateTable struct {
+	rates map[rateKey]int64
+}
+
+// NewCalculator returns a rate table built from TAX_RATES; orders are not
+// taxed when it is empty.
+func NewCalculator(e envs.Envs) (Calculator, error) {
+	rates, err := ParseRates(e.TaxRates)
+	if err != nil {
+		return nil, err
+	}
+	return NewRateTable(rates), nil
+}
+
+// NewRateTable returns a calculator that looks rates up in a table. The most
+// specific rate wins: country, region and category first, then country and
+// region, country and category, the country alone, and finally AnyCountry.
+// Items matching no rate are not taxed.
+func NewRateTable(rates []Rate) Calculator {
+	t := &rateTable{rates: make(map[rateKey]int64, len(rates))}
+	for _, r := range rates {
+		t.rates[key(r.Country, r.Region, r.Category)] = r.Bps
+	}
+	return t
+}
+
+// ParseRates reads comma separated "<country>[-<region>][:<category>]=<percent>"
+// entries, e.g. "US-CA=7.25,US-CA:groceries=0,DE=19,DE:books=7,*=0".
+func ParseRates(s string) ([]Rate, error) {
+	var rates []Rate
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		location, percent, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("tax rate %q: missing '='", entry)
+		}
+		location, category, _ := strings.Cut(strings.TrimSpace(location), ":")
+		country, region, _ := strings.Cut(location, "-")
+		if strings.TrimSpace(country) == "" {
+			return nil, fmt.Errorf("tax rate %q: missing country", entry)
+		}
+		p, err := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		if err != nil || p < 0 || p > 100 {
+			return nil, fmt.Errorf("tax rate %q: percent must be between 0 and 100", entry)
+		}
+		rates = append(rates, Rate{Country: country, Region: region, Category: category, Bps: int64(math.Round(p * 100))})
+	}
+	return rates, nil
+}
+
+func (t *rateTable) Calculate(_ context.Context, order domain.Order) ([]Tax, error) {
+	taxes := make([]Tax, len(order.Items))
+	for i, it := range order.Items {
+		taxable := it.PriceCents*it.Quantity - it.DiscountCents
+		if taxable < 0 {
+			return nil, fmt.Errorf("item %s is discounted below zero", it.ProductID)
+		}
+		bps := t.rate(order.TaxCountry, order.TaxRegion, it.Category)
+		// rounded half up, per item
+		taxes[i] = Tax{RateBps: bps, Cents: (taxable*bps + 5000) / 10000}
+	}
+	return taxes, nil
+}
+
+func (t *rateTable) rate(country string, region string, category string) int64 {
+	for _, k := range []rateKey{
+		key(country, region, category),
+		key(country, region, ""),
+		key(country, "", category),
+		key(country, "", ""),
+		key(AnyCountry, "", category),
+		key(AnyCountry, "", ""),
+	} {
+		// an order without a country only gets the AnyCountry rates
+		if k.country == "" {
+			continue
+		}
+		if bps, ok := t.rates[k]; ok {
+			return bps
+		}
+	}
+	return 0
+}
+
+func key(country string, region string, category string) rateKey {
+	return rateKey{
+		country:  strings.ToUpper(strings.TrimSpace(country)),
+		region:   strings.ToUpper(strings.TrimSpace(region)),
+		category: strings.ToLower(strings.TrimSpace(category)),
+	}
+}
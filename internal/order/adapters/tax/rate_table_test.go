@@ -0,0 +1,84 @@
+package tax
+
+import (
+	"context"
+	"testing"
+
+	"r2-challenge/internal/order/domain"
+)
+
+func TestParseRates(t *testing.T) {
+	rates, err := ParseRates(" US-CA=7.25, de:Books=7 ,*=0")
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	want := []Rate{
+		{Country: "US", Region: "CA", Bps: 725},
+		{Country: "de", Category: "Books", Bps: 700},
+		{Country: "*", Bps: 0},
+	}
+	if len(rates) != len(want) {
+		t.Fatalf("unexpected rates: %+v", rates)
+	}
+	for i := range want {
+		if rates[i] != want[i] {
+			t.Fatalf("rate %d: got %+v, want %+v", i, rates[i], want[i])
+		}
+	}
+
+	for _, bad := range []string{"US", "=5", "US=abc", "US=101", "US=-1"} {
+		if _, err := ParseRates(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestRateTable_MostSpecificRateWins(t *testing.T) {
+	rates, _ := ParseRates("US-CA=7.25,US-CA:groceries=0,US=5,US:books=2,*=10")
+	calc := NewRateTable(rates)
+
+	cases := []struct {
+		name     string
+		country  string
+		region   string
+		category string
+		bps      int64
+	}{
+		{"region and category", "us", "ca", "Groceries", 0},
+		{"region", "US", "CA", "books", 725},
+		{"country and category", "US", "NY", "books", 200},
+		{"country", "US", "NY", "toys", 500},
+		{"any country", "FR", "", "toys", 1000},
+		{"no country", "", "", "toys", 1000},
+	}
+	for _, tc := range cases {
+		order := domain.Order{TaxCountry: tc.country, TaxRegion: tc.region, Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1, PriceCents: 1000, Category: tc.category}}}
+		taxes, err := calc.Calculate(context.Background(), order)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if taxes[0].RateBps != tc.bps {
+			t.Fatalf("%s: got %d bps, want %d", tc.name, taxes[0].RateBps, tc.bps)
+		}
+	}
+}
+
+func TestRateTable_TaxesDiscountedItemsRoundingHalfUp(t *testing.T) {
+	calc := NewRateTable([]Rate{{Country: "US", Region: "CA", Bps: 725}})
+
+	taxes, err := calc.Calculate(context.Background(), domain.Order{TaxCountry: "US", TaxRegion: "CA", Items: []domain.OrderItem{
+		{ProductID: "p1", Quantity: 2, PriceCents: 1000, DiscountCents: 200}, // 1800 * 7.25% = 130.5
+		{ProductID: "p2", Quantity: 1, PriceCents: 999},                      // 72.4275
+	}})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if taxes[0].Cents != 131 || taxes[1].Cents != 72 {
+		t.Fatalf("unexpected taxes: %+v", taxes)
+	}
+
+	taxes, _ = calc.Calculate(context.Background(), domain.Order{TaxCountry: "DE", Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1, PriceCents: 1000}}})
+	if taxes[0] != (Tax{}) {
+		t.Fatalf("expected no tax without a matching rate, got %+v", taxes[0])
+	}
+}
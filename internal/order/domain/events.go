@@ -0,0 +1,20 @@
+package domain
+
+// Outbox topics published by the order module.
+const (
+	// TopicOrderPlaced carries the saved Order.
+	TopicOrderPlaced = "order.placed"
+	// TopicOrderStatusChanged carries a StatusChanged.
+	TopicOrderStatusChanged = "order.status_changed"
+	// TopicOrderExpired carries the Order cancelled because it was never
+	// paid; it follows the order's status change to cancelled.
+	TopicOrderExpired = "order.expired"
+)
+
+// StatusChanged records an order moving from one lifecycle status to another.
+type StatusChanged struct {
+	OrderID string `json:"order_id"`
+	UserID  string `json:"user_id"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
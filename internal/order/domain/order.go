@@ -1,24 +1,86 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
-// Order is the core domain type for orders.
+// ErrInvalidShippingAddress is returned when an order has no usable shipping address.
+var ErrInvalidShippingAddress = errors.New("a valid shipping address is required")
+
+// Order is the core domain type for orders. TotalCents is what the customer
+// pays: SubtotalCents, the catalog price of the items, minus DiscountCents
+// plus TaxCents and ShippingCents. Tax is charged at the TaxCountry and
+// TaxRegion location, which is the shipping address. ShippingAddress is a
+// copy of the destination taken at placement.
 type Order struct {
-	ID         string      `json:"id"`
-	UserID     string      `json:"user_id" validate:"required"`
-	Status     string      `json:"status"`
-	TotalCents int64       `json:"total_cents"`
-	Items      []OrderItem `json:"items"`
-	CreatedAt  time.Time   `json:"created_at"`
-	UpdatedAt  time.Time   `json:"updated_at"`
-	DeletedAt  *time.Time  `json:"deleted_at"`
+	ID              string      `json:"id"`
+	UserID          string      `json:"user_id" validate:"required"`
+	Status          string      `json:"status"`
+	SubtotalCents   int64       `json:"subtotal_cents"`
+	DiscountCents   int64       `json:"discount_cents"`
+	TaxCents        int64       `json:"tax_cents"`
+	ShippingCents   int64       `json:"shipping_cents"`
+	TotalCents      int64       `json:"total_cents"`
+	CouponCode      string      `json:"coupon_code,omitempty"`
+	TaxCountry      string      `json:"tax_country,omitempty"`
+	TaxRegion       string      `json:"tax_region,omitempty"`
+	ShippingAddress *Address    `json:"shipping_address,omitempty" gorm:"serializer:json"`
+	ShippingMethod  string      `json:"shipping_method,omitempty"`
+	Items           []OrderItem `json:"items"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+	DeletedAt       *time.Time  `json:"deleted_at"`
+	// ShippingAddressID picks the shipping address from the user's address
+	// book when ShippingAddress is not given.
+	ShippingAddressID string `json:"-" gorm:"-"`
+}
+
+// Address is where an order ships to. Country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	Name       string `json:"name" validate:"required,max=200"`
+	Line1      string `json:"line1" validate:"required,max=200"`
+	Line2      string `json:"line2,omitempty" validate:"max=200"`
+	City       string `json:"city" validate:"required,max=100"`
+	Region     string `json:"region,omitempty" validate:"max=16"`
+	PostalCode string `json:"postal_code" validate:"required,max=20"`
+	Country    string `json:"country" validate:"required,len=2,alpha"`
+	Phone      string `json:"phone,omitempty" validate:"max=32"`
 }
 
+// OrderItem is one line of an order; its amounts add up like the order's,
+// with tax charged at TaxRateBps (basis points) on the discounted subtotal.
 type OrderItem struct {
-	ID         string     `json:"id"`
-	OrderID    string     `json:"order_id"`
-	ProductID  string     `json:"product_id" validate:"required"`
-	Quantity   int64      `json:"quantity" validate:"required,gt=0"`
-	PriceCents int64      `json:"price_cents" validate:"required,gte=0"`
-	DeletedAt  *time.Time `json:"deleted_at"`
+	ID            string     `json:"id"`
+	OrderID       string     `json:"order_id"`
+	ProductID     string     `json:"product_id" validate:"required"`
+	ProductName   string     `json:"product_name"`
+	Quantity      int64      `json:"quantity" validate:"required,gt=0"`
+	PriceCents    int64      `json:"price_cents" validate:"gte=0"`
+	SubtotalCents int64      `json:"subtotal_cents"`
+	DiscountCents int64      `json:"discount_cents"`
+	TaxRateBps    int64      `json:"tax_rate_bps"`
+	TaxCents      int64      `json:"tax_cents"`
+	TotalCents    int64      `json:"total_cents"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+	// ExpectedPriceCents is the unit price the client saw when ordering; when
+	// set, placement fails with PriceChangedError if the catalog price differs.
+	ExpectedPriceCents int64 `json:"-" gorm:"-"`
+	// Category and WeightGrams are read from the catalog at placement to
+	// price tax and shipping.
+	Category    string `json:"-" gorm:"-"`
+	WeightGrams int64  `json:"-" gorm:"-"`
+}
+
+// PriceChangedError reports that a product's catalog price no longer matches
+// the price the client expected to pay.
+type PriceChangedError struct {
+	ProductID     string
+	ExpectedCents int64
+	ActualCents   int64
+}
+
+func (e PriceChangedError) Error() string {
+	return fmt.Sprintf("price changed for product %s: expected %d, got %d", e.ProductID, e.ExpectedCents, e.ActualCents)
 }
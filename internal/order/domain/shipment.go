@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Shipment statuses.
+const (
+	ShipmentShipped   = "shipped"
+	ShipmentDelivered = "delivered"
+)
+
+// Outbox topics published for shipments; both carry the Shipment.
+const (
+	TopicShipmentCreated   = "shipment.created"
+	TopicShipmentDelivered = "shipment.delivered"
+)
+
+// ErrInvalidShipment is returned when a shipment does not match what is left
+// to ship on its order; the wrapped message says why.
+var ErrInvalidShipment = errors.New("invalid shipment")
+
+// Shipment is one parcel of an order, holding some quantity of its items.
+type Shipment struct {
+	ID             string         `json:"id" gorm:"primaryKey;type:uuid"`
+	OrderID        string         `json:"order_id" gorm:"type:uuid"`
+	Carrier        string         `json:"carrier"`
+	TrackingNumber string         `json:"tracking_number"`
+	Status         string         `json:"status"`
+	Items          []ShipmentItem `json:"items" gorm:"-"`
+	ShippedAt      time.Time      `json:"shipped_at"`
+	DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// ShipmentItem is the quantity of one order item packed in a shipment.
+type ShipmentItem struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid"`
+	ShipmentID  string    `json:"shipment_id" gorm:"type:uuid"`
+	OrderItemID string    `json:"order_item_id" gorm:"type:uuid"`
+	Quantity    int64     `json:"quantity"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Unshipped returns the quantity of each order item, by item id, that is in
+// none of the shipments. Items shipped in full are left out.
+func Unshipped(order Order, shipments []Shipment) map[string]int64 {
+	left := make(map[string]int64, len(order.Items))
+	for _, it := range order.Items {
+		left[it.ID] += it.Quantity
+	}
+	for _, s := range shipments {
+		for _, it := range s.Items {
+			left[it.OrderItemID] -= it.Quantity
+		}
+	}
+	for id, n := range left {
+		if n <= 0 {
+			delete(left, id)
+		}
+	}
+	return left
+}
+
+// ValidateShipment checks that s only packs items of the order, in
+// quantities that the earlier shipments left to ship.
+func ValidateShipment(order Order, shipments []Shipment, s Shipment) error {
+	if len(s.Items) == 0 {
+		return fmt.Errorf("%w: nothing to ship", ErrInvalidShipment)
+	}
+	left := Unshipped(order, shipments)
+	ordered := make(map[string]bool, len(order.Items))
+	for _, it := range order.Items {
+		ordered[it.ID] = true
+	}
+	for _, it := range s.Items {
+		switch {
+		case !ordered[it.OrderItemID]:
+			return fmt.Errorf("%w: item %s is not part of the order", ErrInvalidShipment, it.OrderItemID)
+		case it.Quantity <= 0:
+			return fmt.Errorf("%w: quantity of item %s must be positive", ErrInvalidShipment, it.OrderItemID)
+		case it.Quantity > left[it.OrderItemID]:
+			return fmt.Errorf("%w: only %d of item %s left to ship", ErrInvalidShipment, left[it.OrderItemID], it.OrderItemID)
+		}
+		left[it.OrderItemID] -= it.Quantity
+	}
+	return nil
+}
+
+// FulfillmentStatus derives the order status from its shipments: partially
+// shipped while items are left to ship, shipped once everything is in a
+// shipment and delivered once every shipment is. It returns an empty string
+// when nothing has shipped yet.
+func FulfillmentStatus(order Order, shipments []Shipment) string {
+	if len(shipments) == 0 {
+		return ""
+	}
+	if len(Unshipped(order, shipments)) > 0 {
+		return StatusPartiallyShipped
+	}
+	for _, s := range shipments {
+		if s.Status != ShipmentDelivered {
+			return StatusShipped
+		}
+	}
+	return StatusDelivered
+}
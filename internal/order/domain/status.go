@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Order lifecycle statuses.
+const (
+	StatusCreated   = "created"
+	StatusPaid      = "paid"
+	StatusFulfilled = "fulfilled"
+	StatusShipped   = "shipped"
+	StatusDelivered = "delivered"
+	StatusCancelled = "cancelled"
+	StatusRefunded  = "refunded"
+	// some items are in shipments, others still wait to ship
+	StatusPartiallyShipped = "partially_shipped"
+	// charge declined after placement; inventory has been released
+	StatusPaymentFailed = "payment_failed"
+)
+
+// ErrUnknownStatus is returned when a status is not part of the order lifecycle.
+var ErrUnknownStatus = errors.New("unknown order status")
+
+// ErrStatusConflict is returned when the order status changed concurrently
+// between reading it and applying a transition.
+var ErrStatusConflict = errors.New("order status changed concurrently")
+
+// ErrPaymentFailed is returned when the order could not be charged.
+var ErrPaymentFailed = errors.New("payment failed")
+
+// ErrPaymentPending is returned when the provider's answer to a charge is
+// unknown, e.g. after a timeout. The order stays created with its payment
+// pending until a provider event or reconciliation settles it.
+var ErrPaymentPending = errors.New("payment outcome unknown")
+
+// ErrForbidden is returned when the acting user may not operate on the order.
+var ErrForbidden = errors.New("forbidden")
+
+// ErrStatusNotSettable is returned when a status is set directly that only
+// its own flow may reach: cancellation, refund or a failed payment, which
+// also settle the order's payments, stock and coupon.
+var ErrStatusNotSettable = errors.New("order status cannot be set directly")
+
+// transitions lists, for each status, the statuses it may move to.
+var transitions = map[string][]string{
+	StatusCreated:          {StatusPaid, StatusCancelled, StatusPaymentFailed},
+	StatusPaid:             {StatusFulfilled, StatusPartiallyShipped, StatusShipped, StatusCancelled, StatusRefunded},
+	StatusFulfilled:        {StatusPartiallyShipped, StatusShipped, StatusCancelled, StatusRefunded},
+	StatusPartiallyShipped: {StatusShipped, StatusRefunded},
+	StatusShipped:          {StatusDelivered, StatusRefunded},
+	StatusDelivered:        {StatusRefunded},
+	StatusCancelled:        {},
+	StatusRefunded:         {},
+	StatusPaymentFailed:    {},
+}
+
+// TransitionError reports a status change the order lifecycle does not allow.
+type TransitionError struct {
+	From string
+	To   string
+}
+
+func (e TransitionError) Error() string {
+	return fmt.Sprintf("invalid order status transition from %q to %q", e.From, e.To)
+}
+
+// IsKnownStatus reports whether status belongs to the order lifecycle.
+func IsKnownStatus(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+// ValidateTransition checks that an order may move from one status to another.
+func ValidateTransition(from, to string) error {
+	if !IsKnownStatus(to) {
+		return ErrUnknownStatus
+	}
+	for _, next := range transitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return TransitionError{From: from, To: to}
+}
+
+// settledBy tells, for each status that cannot be set directly, how an
+// order reaches it.
+var settledBy = map[string]string{
+	StatusCancelled:     "cancel the order with POST /v1/orders/{id}/cancel",
+	StatusRefunded:      "refund the order with POST /v1/orders/{id}/refunds",
+	StatusPaymentFailed: "only the payment flow sets it",
+}
+
+// ValidateDirectStatus checks that status may be set directly, without the
+// flow that settles the order's payments, stock and coupon.
+func ValidateDirectStatus(status string) error {
+	if how, ok := settledBy[status]; ok {
+		return fmt.Errorf("%w: %q, %s", ErrStatusNotSettable, status, how)
+	}
+	return nil
+}
+
+// OwnerCanCancel reports whether the order owner may still cancel an order in
+// the given status; once fulfillment starts only admins can.
+func OwnerCanCancel(status string) bool {
+	return status == StatusCreated || status == StatusPaid
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// OrderEvent is one entry of an order's timeline: the order moving from
+// FromStatus to ToStatus. FromStatus is empty for the order's placement and
+// ActorID is empty when no user made the change, e.g. a payment webhook.
+type OrderEvent struct {
+	ID         string    `json:"id"`
+	OrderID    string    `json:"order_id"`
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    string    `json:"actor_id,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type ApplyPaymentEventService interface {
+	// Apply updates the payment a provider event refers to and the order it
+	// belongs to. Events are applied once per provider and id; repeats and
+	// events that no longer apply are acknowledged without changes.
+	Apply(ctx context.Context, provider string, event pmtdomain.ProviderEvent) error
+}
+
+type applyPaymentEventService struct {
+	repo           orderdb.OrderRepository
+	providerEvents pmtcmd.ProviderEventService
+	promotions     promocmd.RedeemService
+	events         outboxcmd.PublishService
+	tx             appdb.Transactor
+	tracer         observability.Tracer
+}
+
+func NewApplyPaymentEventService(r orderdb.OrderRepository, pe pmtcmd.ProviderEventService, rd promocmd.RedeemService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (ApplyPaymentEventService, error) {
+	return &applyPaymentEventService{repo: r, providerEvents: pe, promotions: rd, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *applyPaymentEventService) Apply(ctx context.Context, provider string, event pmtdomain.ProviderEvent) error {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.ApplyPaymentEvent")
+	defer span.End()
+
+	// the event is recorded in the same transaction as its effects, so a
+	// failed attempt is applied again when the provider retries
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		fresh, err := s.providerEvents.Record(ctx, provider, event)
+		if err != nil || !fresh {
+			return err
+		}
+
+		payment, err := s.providerEvents.Apply(ctx, provider, event)
+		if errors.Is(err, pmtdomain.ErrUnsupportedEvent) || errors.Is(err, pmtdomain.ErrInvalidPaymentTransition) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		topic := pmtdomain.TopicPaymentFailed
+		if payment.Status == pmtdomain.StatusChargedBack {
+			topic = pmtdomain.TopicPaymentChargedBack
+		}
+		if err := s.events.Publish(ctx, topic, payment); err != nil {
+			return err
+		}
+
+		return s.updateOrder(ctx, payment)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// updateOrder reflects the payment outcome on its order: a failed payment
+// releases an order that has not progressed yet, together with its coupon,
+// and a chargeback refunds it, or releases it as cancelled while it is still
+// created. Orders already past those points keep their status.
+func (s *applyPaymentEventService) updateOrder(ctx context.Context, payment pmtdomain.Payment) error {
+	current, err := s.repo.GetByID(ctx, payment.OrderID)
+	if err != nil {
+		return err
+	}
+
+	var updated domain.Order
+	switch {
+	case payment.Status == pmtdomain.StatusFailed && current.Status == domain.StatusCreated:
+		updated, err = s.repo.Release(ctx, current.ID, current.Status, domain.StatusPaymentFailed, "payment failed")
+		if err == nil && updated.CouponCode != "" {
+			err = s.promotions.Release(ctx, updated.ID)
+		}
+	case payment.Status == pmtdomain.StatusChargedBack && current.Status == domain.StatusCreated:
+		// created cannot move to refunded, so the order is released instead
+		updated, err = s.repo.Release(ctx, current.ID, current.Status, domain.StatusCancelled, "payment charged back")
+		if err == nil && updated.CouponCode != "" {
+			err = s.promotions.Release(ctx, updated.ID)
+		}
+	case payment.Status == pmtdomain.StatusChargedBack && domain.ValidateTransition(current.Status, domain.StatusRefunded) == nil:
+		updated, err = s.repo.UpdateStatus(ctx, current.ID, current.Status, domain.StatusRefunded, "payment charged back")
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+		OrderID: updated.ID, UserID: updated.UserID, From: current.Status, To: updated.Status,
+	})
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/apply_payment_event.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockApplyPaymentEventService is a mock of ApplyPaymentEventService interface.
+type MockApplyPaymentEventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplyPaymentEventServiceMockRecorder
+}
+
+// MockApplyPaymentEventServiceMockRecorder is the mock recorder for MockApplyPaymentEventService.
+type MockApplyPaymentEventServiceMockRecorder struct {
+	mock *MockApplyPaymentEventService
+}
+
+// NewMockApplyPaymentEventService creates a new mock instance.
+func NewMockApplyPaymentEventService(ctrl *gomock.Controller) *MockApplyPaymentEventService {
+	mock := &MockApplyPaymentEventService{ctrl: ctrl}
+	mock.recorder = &MockApplyPaymentEventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplyPaymentEventService) EXPECT() *MockApplyPaymentEventServiceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockApplyPaymentEventService) Apply(ctx context.Context, provider string, event domain.ProviderEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, provider, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockApplyPaymentEventServiceMockRecorder) Apply(ctx, provider, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockApplyPaymentEventService)(nil).Apply), ctx, provider, event)
+}
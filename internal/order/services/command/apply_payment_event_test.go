@@ -0,0 +1,144 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+func TestApplyPaymentEvent_FailureReleasesNewOrder(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	providerEvents := pmtcmd.NewMockProviderEventService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewApplyPaymentEventService(repo, providerEvents, promocmd.NewMockRedeemService(ctrl), events, stubTx{}, tracer)
+
+	event := pmtdomain.ProviderEvent{ID: "evt_1", Type: pmtdomain.ProviderEventFailed, Reference: "rcpt_1"}
+	providerEvents.EXPECT().Record(gomock.Any(), "gateway", event).Return(true, nil)
+	providerEvents.EXPECT().Apply(gomock.Any(), "gateway", event).Return(pmtdomain.Payment{ID: "p1", OrderID: "o1", Status: pmtdomain.StatusFailed}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentFailed, gomock.Any()).Return(nil)
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCreated}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusPaymentFailed, "payment failed").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusPaymentFailed}, nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, domain.StatusChanged{OrderID: "o1", UserID: "u1", From: domain.StatusCreated, To: domain.StatusPaymentFailed}).Return(nil)
+
+	if err := s.Apply(context.Background(), "gateway", event); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+}
+
+func TestApplyPaymentEvent_FailureReleasesCoupon(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	providerEvents := pmtcmd.NewMockProviderEventService(ctrl)
+	promotions := promocmd.NewMockRedeemService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewApplyPaymentEventService(repo, providerEvents, promotions, events, stubTx{}, tracer)
+
+	event := pmtdomain.ProviderEvent{ID: "evt_4", Type: pmtdomain.ProviderEventFailed, Reference: "rcpt_1"}
+	providerEvents.EXPECT().Record(gomock.Any(), "gateway", event).Return(true, nil)
+	providerEvents.EXPECT().Apply(gomock.Any(), "gateway", event).Return(pmtdomain.Payment{ID: "p1", OrderID: "o1", Status: pmtdomain.StatusFailed}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentFailed, gomock.Any()).Return(nil)
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusCreated, CouponCode: "SAVE10"}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusPaymentFailed, "payment failed").Return(domain.Order{ID: "o1", Status: domain.StatusPaymentFailed, CouponCode: "SAVE10"}, nil)
+	promotions.EXPECT().Release(gomock.Any(), "o1").Return(nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, gomock.Any()).Return(nil)
+
+	if err := s.Apply(context.Background(), "gateway", event); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+}
+
+func TestApplyPaymentEvent_ChargebackRefundsOrder(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	providerEvents := pmtcmd.NewMockProviderEventService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewApplyPaymentEventService(repo, providerEvents, promocmd.NewMockRedeemService(ctrl), events, stubTx{}, tracer)
+
+	event := pmtdomain.ProviderEvent{ID: "evt_2", Type: pmtdomain.ProviderEventChargedBack, Reference: "rcpt_1"}
+	providerEvents.EXPECT().Record(gomock.Any(), "gateway", event).Return(true, nil)
+	providerEvents.EXPECT().Apply(gomock.Any(), "gateway", event).Return(pmtdomain.Payment{ID: "p1", OrderID: "o1", Status: pmtdomain.StatusChargedBack}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentChargedBack, gomock.Any()).Return(nil)
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusDelivered}, nil)
+	repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusDelivered, domain.StatusRefunded, "payment charged back").Return(domain.Order{ID: "o1", Status: domain.StatusRefunded}, nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, gomock.Any()).Return(nil)
+
+	if err := s.Apply(context.Background(), "gateway", event); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+}
+
+func TestApplyPaymentEvent_ChargebackReleasesCreatedOrder(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	providerEvents := pmtcmd.NewMockProviderEventService(ctrl)
+	promotions := promocmd.NewMockRedeemService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewApplyPaymentEventService(repo, providerEvents, promotions, events, stubTx{}, tracer)
+
+	event := pmtdomain.ProviderEvent{ID: "evt_5", Type: pmtdomain.ProviderEventChargedBack, Reference: "rcpt_1"}
+	providerEvents.EXPECT().Record(gomock.Any(), "gateway", event).Return(true, nil)
+	providerEvents.EXPECT().Apply(gomock.Any(), "gateway", event).Return(pmtdomain.Payment{ID: "p1", OrderID: "o1", Status: pmtdomain.StatusChargedBack}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentChargedBack, gomock.Any()).Return(nil)
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCreated, CouponCode: "SAVE10"}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusCancelled, "payment charged back").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCancelled, CouponCode: "SAVE10"}, nil)
+	promotions.EXPECT().Release(gomock.Any(), "o1").Return(nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, domain.StatusChanged{OrderID: "o1", UserID: "u1", From: domain.StatusCreated, To: domain.StatusCancelled}).Return(nil)
+
+	if err := s.Apply(context.Background(), "gateway", event); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+}
+
+func TestApplyPaymentEvent_DuplicateIsIgnored(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	providerEvents := pmtcmd.NewMockProviderEventService(ctrl)
+	s, _ := NewApplyPaymentEventService(orderdb.NewMockOrderRepository(ctrl), providerEvents, promocmd.NewMockRedeemService(ctrl), outboxcmd.NewMockPublishService(ctrl), stubTx{}, tracer)
+
+	event := pmtdomain.ProviderEvent{ID: "evt_1", Type: pmtdomain.ProviderEventFailed, Reference: "rcpt_1"}
+	providerEvents.EXPECT().Record(gomock.Any(), "gateway", event).Return(false, nil)
+
+	if err := s.Apply(context.Background(), "gateway", event); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+}
+
+func TestApplyPaymentEvent_StaleEventChangesNothing(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	providerEvents := pmtcmd.NewMockProviderEventService(ctrl)
+	s, _ := NewApplyPaymentEventService(orderdb.NewMockOrderRepository(ctrl), providerEvents, promocmd.NewMockRedeemService(ctrl), outboxcmd.NewMockPublishService(ctrl), stubTx{}, tracer)
+
+	event := pmtdomain.ProviderEvent{ID: "evt_3", Type: pmtdomain.ProviderEventChargedBack, Reference: "rcpt_1"}
+	providerEvents.EXPECT().Record(gomock.Any(), "gateway", event).Return(true, nil)
+	providerEvents.EXPECT().Apply(gomock.Any(), "gateway", event).Return(pmtdomain.Payment{}, pmtdomain.ErrInvalidPaymentTransition)
+
+	if err := s.Apply(context.Background(), "gateway", event); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type CancelOrderService interface {
+	// Cancel cancels the order on behalf of userID. Owners may cancel until
+	// fulfillment starts; admins may cancel whenever the lifecycle allows it.
+	Cancel(ctx context.Context, orderID string, userID string, isAdmin bool) (domain.Order, error)
+}
+
+type cancelOrderService struct {
+	repo           orderdb.OrderRepository
+	refundsSvc     pmtcmd.RefundService
+	authorizations pmtcmd.AuthorizationService
+	events         outboxcmd.PublishService
+	tx             appdb.Transactor
+	tracer         observability.Tracer
+}
+
+func NewCancelOrderService(r orderdb.OrderRepository, rs pmtcmd.RefundService, as pmtcmd.AuthorizationService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (CancelOrderService, error) {
+	return &cancelOrderService{repo: r, refundsSvc: rs, authorizations: as, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *cancelOrderService) Cancel(ctx context.Context, orderID string, userID string, isAdmin bool) (domain.Order, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.Cancel")
+	defer span.End()
+
+	current, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
+	}
+
+	if !isAdmin {
+		if current.UserID != userID {
+			span.RecordError(domain.ErrForbidden)
+			return domain.Order{}, domain.ErrForbidden
+		}
+		if !domain.OwnerCanCancel(current.Status) {
+			err := domain.TransitionError{From: current.Status, To: domain.StatusCancelled}
+			span.RecordError(err)
+			return domain.Order{}, err
+		}
+	}
+
+	if err := domain.ValidateTransition(current.Status, domain.StatusCancelled); err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
+	}
+
+	var cancelled domain.Order
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		cancelled, err = s.repo.Release(ctx, orderID, current.Status, domain.StatusCancelled, "order cancelled")
+		if err != nil {
+			return err
+		}
+
+		refunded, err := s.refundsSvc.Refund(ctx, orderID, 0, "order cancelled")
+		if err != nil && !errors.Is(err, pmtdomain.ErrNothingToRefund) {
+			return err
+		}
+
+		// refunds and voids reach the processor once the cancellation commits
+		if err := publishRefunds(ctx, s.events, refunded.Refunds); err != nil {
+			return err
+		}
+		if err := voidAuthorized(ctx, s.authorizations, s.events, orderID); err != nil {
+			return err
+		}
+
+		return s.events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+			OrderID: cancelled.ID, UserID: cancelled.UserID, From: current.Status, To: cancelled.Status,
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
+	}
+
+	return cancelled, nil
+}
+
+// voidAuthorized records every authorized payment of the order as voided,
+// publishing payment.voided for each. It must run inside a transaction so the
+// payments stay locked. The processor releases the authorizations only after
+// the transaction commits, through the payment-voids outbox subscriber
+// (SendVoidService), so a rollback never leaves an authorization released
+// for an order that was not cancelled.
+func voidAuthorized(ctx context.Context, authorizations pmtcmd.AuthorizationService, events outboxcmd.PublishService, orderID string) error {
+	authorized, err := authorizations.Authorized(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range authorized {
+		voided, err := authorizations.MarkVoided(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		if err := events.Publish(ctx, pmtdomain.TopicPaymentVoided, voided); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
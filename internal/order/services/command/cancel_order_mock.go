@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/cancel_order.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCancelOrderService is a mock of CancelOrderService interface.
+type MockCancelOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelOrderServiceMockRecorder
+}
+
+// MockCancelOrderServiceMockRecorder is the mock recorder for MockCancelOrderService.
+type MockCancelOrderServiceMockRecorder struct {
+	mock *MockCancelOrderService
+}
+
+// NewMockCancelOrderService creates a new mock instance.
+func NewMockCancelOrderService(ctrl *gomock.Controller) *MockCancelOrderService {
+	mock := &MockCancelOrderService{ctrl: ctrl}
+	mock.recorder = &MockCancelOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelOrderService) EXPECT() *MockCancelOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockCancelOrderService) Cancel(ctx context.Context, orderID, userID string, isAdmin bool) (domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, orderID, userID, isAdmin)
+	ret0, _ := ret[0].(domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockCancelOrderServiceMockRecorder) Cancel(ctx, orderID, userID, isAdmin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockCancelOrderService)(nil).Cancel), ctx, orderID, userID, isAdmin)
+}
@@ -0,0 +1,169 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+// stubTx runs the unit of work inline, standing in for a database transaction.
+type stubTx struct{}
+
+func (stubTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// stubPublisher accepts every outbox event.
+type stubPublisher struct{}
+
+func (stubPublisher) Publish(context.Context, string, any) error { return nil }
+
+// noAuthorizations reports that the order has no payments awaiting capture.
+type noAuthorizations struct{}
+
+func (noAuthorizations) Authorized(context.Context, string) ([]pmtdomain.Payment, error) {
+	return nil, nil
+}
+
+func (noAuthorizations) MarkCaptured(context.Context, string, string) (pmtdomain.Payment, error) {
+	return pmtdomain.Payment{}, errors.New("no authorizations")
+}
+
+func (noAuthorizations) MarkVoided(context.Context, string) (pmtdomain.Payment, error) {
+	return pmtdomain.Payment{}, errors.New("no authorizations")
+}
+
+func (noAuthorizations) MarkFailed(context.Context, string) (pmtdomain.Payment, error) {
+	return pmtdomain.Payment{}, errors.New("no authorizations")
+}
+
+func TestCancelOrder_OwnerRestocksAndRefunds(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, _ := NewCancelOrderService(repo, refunds, noAuthorizations{}, events, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusPaid}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusPaid, domain.StatusCancelled, "order cancelled").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCancelled}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(0), gomock.Any()).Return(pmtdomain.RefundResult{Refunds: []pmtdomain.Refund{{ReceiptID: "rcpt_1", AmountCents: 1000}}}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentRefunded, gomock.Any()).Return(nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, domain.StatusChanged{OrderID: "o1", UserID: "u1", From: domain.StatusPaid, To: domain.StatusCancelled}).Return(nil)
+
+	res, err := s.Cancel(context.Background(), "o1", "u1", false)
+	if err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	if res.Status != domain.StatusCancelled {
+		t.Fatalf("unexpected status: %s", res.Status)
+	}
+}
+
+func TestCancelOrder_RejectsOtherUsers(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	s, _ := NewCancelOrderService(repo, pmtcmd.NewMockRefundService(ctrl), noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCreated}, nil)
+
+	if _, err := s.Cancel(context.Background(), "o1", "u2", false); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestCancelOrder_OwnerCannotCancelAfterFulfillment(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	s, _ := NewCancelOrderService(repo, pmtcmd.NewMockRefundService(ctrl), noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusFulfilled}, nil)
+
+	_, err := s.Cancel(context.Background(), "o1", "u1", false)
+	var transitionErr domain.TransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("expected TransitionError, got %v", err)
+	}
+}
+
+func TestCancelOrder_AdminCanCancelFulfilled(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	s, _ := NewCancelOrderService(repo, refunds, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusFulfilled}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusFulfilled, domain.StatusCancelled, "order cancelled").Return(domain.Order{ID: "o1", Status: domain.StatusCancelled}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(0), gomock.Any()).Return(pmtdomain.RefundResult{}, pmtdomain.ErrNothingToRefund)
+
+	if _, err := s.Cancel(context.Background(), "o1", "admin1", true); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+}
+
+func TestCancelOrder_VoidRecordFailureFails(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	authorizations := pmtcmd.NewMockAuthorizationService(ctrl)
+	s, _ := NewCancelOrderService(repo, refunds, authorizations, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCreated}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusCancelled, "order cancelled").Return(domain.Order{ID: "o1", Status: domain.StatusCancelled}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(0), gomock.Any()).Return(pmtdomain.RefundResult{}, pmtdomain.ErrNothingToRefund)
+	authorizations.EXPECT().Authorized(gomock.Any(), "o1").Return([]pmtdomain.Payment{{ID: "p1", AuthorizationID: "auth_1", AmountCents: 500}}, nil)
+	authorizations.EXPECT().MarkVoided(gomock.Any(), "p1").Return(pmtdomain.Payment{}, errors.New("db down"))
+
+	if _, err := s.Cancel(context.Background(), "o1", "u1", false); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+// The processor is not called here: voids reach it from the outbox once the
+// cancellation commits.
+func TestCancelOrder_RecordsVoidsForAuthorizedPayments(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	authorizations := pmtcmd.NewMockAuthorizationService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewCancelOrderService(repo, refunds, authorizations, events, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCreated}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusCancelled, "order cancelled").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCancelled}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(0), gomock.Any()).Return(pmtdomain.RefundResult{}, pmtdomain.ErrNothingToRefund)
+	authorizations.EXPECT().Authorized(gomock.Any(), "o1").Return([]pmtdomain.Payment{{ID: "p1", AuthorizationID: "auth_1", AmountCents: 1000}}, nil)
+	authorizations.EXPECT().MarkVoided(gomock.Any(), "p1").Return(pmtdomain.Payment{ID: "p1", Status: pmtdomain.StatusVoided}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentVoided, gomock.Any()).Return(nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, gomock.Any()).Return(nil)
+
+	if _, err := s.Cancel(context.Background(), "o1", "u1", false); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+}
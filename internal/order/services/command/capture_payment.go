@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/order/adapters/payment"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type CapturePaymentService interface {
+	// Capture collects an authorized payment whose capture was requested and
+	// records the receipt, publishing payment.captured. The processor keys
+	// the capture by authorization, so repeated deliveries collect once. A
+	// declined capture marks the payment failed and publishes payment.failed;
+	// other errors are returned so the outbox retries.
+	Capture(ctx context.Context, p pmtdomain.Payment) error
+}
+
+type capturePaymentService struct {
+	payments       payment.Processor
+	authorizations pmtcmd.AuthorizationService
+	events         outboxcmd.PublishService
+	tracer         observability.Tracer
+}
+
+func NewCapturePaymentService(p payment.Processor, as pmtcmd.AuthorizationService, ev outboxcmd.PublishService, t observability.Tracer) (CapturePaymentService, error) {
+	return &capturePaymentService{payments: p, authorizations: as, events: ev, tracer: t}, nil
+}
+
+func (s *capturePaymentService) Capture(ctx context.Context, p pmtdomain.Payment) error {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.CapturePayment")
+	defer span.End()
+
+	topic := pmtdomain.TopicPaymentCaptured
+	receiptID, err := s.payments.Capture(ctx, p.AuthorizationID, p.AmountCents)
+	var recorded pmtdomain.Payment
+	switch {
+	case errors.Is(err, payment.ErrDeclined):
+		topic = pmtdomain.TopicPaymentFailed
+		recorded, err = s.authorizations.MarkFailed(ctx, p.ID)
+	case err != nil:
+		span.RecordError(err)
+		return err
+	default:
+		recorded, err = s.authorizations.MarkCaptured(ctx, p.ID, receiptID)
+	}
+	// an earlier request for the same authorization already recorded it
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.events.Publish(ctx, topic, recorded); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/capture_payment.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCapturePaymentService is a mock of CapturePaymentService interface.
+type MockCapturePaymentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCapturePaymentServiceMockRecorder
+}
+
+// MockCapturePaymentServiceMockRecorder is the mock recorder for MockCapturePaymentService.
+type MockCapturePaymentServiceMockRecorder struct {
+	mock *MockCapturePaymentService
+}
+
+// NewMockCapturePaymentService creates a new mock instance.
+func NewMockCapturePaymentService(ctrl *gomock.Controller) *MockCapturePaymentService {
+	mock := &MockCapturePaymentService{ctrl: ctrl}
+	mock.recorder = &MockCapturePaymentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCapturePaymentService) EXPECT() *MockCapturePaymentServiceMockRecorder {
+	return m.recorder
+}
+
+// Capture mocks base method.
+func (m *MockCapturePaymentService) Capture(ctx context.Context, p domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockCapturePaymentServiceMockRecorder) Capture(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockCapturePaymentService)(nil).Capture), ctx, p)
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+	paymentmock "r2-challenge/internal/order/adapters/payment"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+var toCapture = pmtdomain.Payment{ID: "p1", OrderID: "o1", AuthorizationID: "auth_1", AmountCents: 1000, Status: pmtdomain.StatusAuthorized}
+
+func TestCapturePayment_RecordsReceipt(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	payments := paymentmock.NewMockProcessor(ctrl)
+	authorizations := pmtcmd.NewMockAuthorizationService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewCapturePaymentService(payments, authorizations, events, tracer)
+
+	captured := pmtdomain.Payment{ID: "p1", ReceiptID: "rcpt_1", Status: pmtdomain.StatusCaptured}
+	payments.EXPECT().Capture(gomock.Any(), "auth_1", int64(1000)).Return("rcpt_1", nil)
+	authorizations.EXPECT().MarkCaptured(gomock.Any(), "p1", "rcpt_1").Return(captured, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentCaptured, captured).Return(nil)
+
+	if err := s.Capture(context.Background(), toCapture); err != nil {
+		t.Fatalf("Capture failed: %v", err)
+	}
+}
+
+func TestCapturePayment_DeclineFailsPayment(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	payments := paymentmock.NewMockProcessor(ctrl)
+	authorizations := pmtcmd.NewMockAuthorizationService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewCapturePaymentService(payments, authorizations, events, tracer)
+
+	failed := pmtdomain.Payment{ID: "p1", Status: pmtdomain.StatusFailed}
+	payments.EXPECT().Capture(gomock.Any(), "auth_1", int64(1000)).Return("", fmt.Errorf("%w: authorization expired", paymentmock.ErrDeclined))
+	authorizations.EXPECT().MarkFailed(gomock.Any(), "p1").Return(failed, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentFailed, failed).Return(nil)
+
+	if err := s.Capture(context.Background(), toCapture); err != nil {
+		t.Fatalf("Capture failed: %v", err)
+	}
+}
+
+func TestCapturePayment_UnknownOutcomeIsRetried(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	payments := paymentmock.NewMockProcessor(ctrl)
+	s, _ := NewCapturePaymentService(payments, pmtcmd.NewMockAuthorizationService(ctrl), outboxcmd.NewMockPublishService(ctrl), tracer)
+
+	payments.EXPECT().Capture(gomock.Any(), "auth_1", int64(1000)).Return("", errors.New("gateway down"))
+
+	if err := s.Capture(context.Background(), toCapture); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestCapturePayment_AlreadyRecordedIsAcknowledged(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	payments := paymentmock.NewMockProcessor(ctrl)
+	authorizations := pmtcmd.NewMockAuthorizationService(ctrl)
+	s, _ := NewCapturePaymentService(payments, authorizations, outboxcmd.NewMockPublishService(ctrl), tracer)
+
+	// a second request for the same authorization gets the first receipt back
+	payments.EXPECT().Capture(gomock.Any(), "auth_1", int64(1000)).Return("rcpt_1", nil)
+	authorizations.EXPECT().MarkCaptured(gomock.Any(), "p1", "rcpt_1").Return(pmtdomain.Payment{}, gorm.ErrRecordNotFound)
+
+	if err := s.Capture(context.Background(), toCapture); err != nil {
+		t.Fatalf("Capture failed: %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateShipmentService interface {
+	// Create records a parcel of the order and moves the order to
+	// partially_shipped or shipped. A shipment without items ships
+	// everything not shipped yet.
+	Create(ctx context.Context, orderID string, shipment domain.Shipment) (domain.Shipment, error)
+}
+
+type createShipmentService struct {
+	repo           orderdb.OrderRepository
+	shipments      orderdb.ShipmentRepository
+	authorizations pmtcmd.AuthorizationService
+	events         outboxcmd.PublishService
+	tx             appdb.Transactor
+	tracer         observability.Tracer
+}
+
+func NewCreateShipmentService(r orderdb.OrderRepository, sr orderdb.ShipmentRepository, as pmtcmd.AuthorizationService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (CreateShipmentService, error) {
+	return &createShipmentService{repo: r, shipments: sr, authorizations: as, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *createShipmentService) Create(ctx context.Context, orderID string, shipment domain.Shipment) (domain.Shipment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.CreateShipment")
+	defer span.End()
+
+	var saved domain.Shipment
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.shipments.Lock(ctx, orderID); err != nil {
+			return err
+		}
+		order, err := s.repo.GetByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		shipped, err := s.shipments.ListByOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if len(shipment.Items) == 0 {
+			left := domain.Unshipped(order, shipped)
+			for _, it := range order.Items {
+				if n := left[it.ID]; n > 0 {
+					shipment.Items = append(shipment.Items, domain.ShipmentItem{OrderItemID: it.ID, Quantity: n})
+				}
+			}
+		}
+		if err := domain.ValidateShipment(order, shipped, shipment); err != nil {
+			return err
+		}
+		// refuse before saving when the order cannot ship, e.g. unpaid or cancelled
+		status := domain.FulfillmentStatus(order, append(shipped, shipment))
+		if status != order.Status {
+			if err := domain.ValidateTransition(order.Status, status); err != nil {
+				return err
+			}
+		}
+
+		shipment.OrderID = orderID
+		saved, err = s.shipments.Save(ctx, shipment)
+		if err != nil {
+			return err
+		}
+		if err := s.events.Publish(ctx, domain.TopicShipmentCreated, saved); err != nil {
+			return err
+		}
+
+		if status == order.Status {
+			return nil
+		}
+		// authorized payments are collected once the first parcel leaves
+		if err := requestCaptures(ctx, s.authorizations, s.events, orderID); err != nil {
+			return err
+		}
+		return moveStatus(ctx, s.repo, s.events, order, status, "shipment "+saved.ID+" created")
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Shipment{}, err
+	}
+
+	return saved, nil
+}
+
+// moveStatus applies a status change the caller already validated and
+// publishes it.
+func moveStatus(ctx context.Context, r orderdb.OrderRepository, events outboxcmd.PublishService, order domain.Order, status string, reason string) error {
+	updated, err := r.UpdateStatus(ctx, order.ID, order.Status, status, reason)
+	if err != nil {
+		return err
+	}
+	return events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+		OrderID: updated.ID, UserID: updated.UserID, From: order.Status, To: updated.Status,
+	})
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/create_shipment.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateShipmentService is a mock of CreateShipmentService interface.
+type MockCreateShipmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateShipmentServiceMockRecorder
+}
+
+// MockCreateShipmentServiceMockRecorder is the mock recorder for MockCreateShipmentService.
+type MockCreateShipmentServiceMockRecorder struct {
+	mock *MockCreateShipmentService
+}
+
+// NewMockCreateShipmentService creates a new mock instance.
+func NewMockCreateShipmentService(ctrl *gomock.Controller) *MockCreateShipmentService {
+	mock := &MockCreateShipmentService{ctrl: ctrl}
+	mock.recorder = &MockCreateShipmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateShipmentService) EXPECT() *MockCreateShipmentServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateShipmentService) Create(ctx context.Context, orderID string, shipment domain.Shipment) (domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, orderID, shipment)
+	ret0, _ := ret[0].(domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateShipmentServiceMockRecorder) Create(ctx, orderID, shipment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateShipmentService)(nil).Create), ctx, orderID, shipment)
+}
@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func shippableOrder(status string) domain.Order {
+	return domain.Order{ID: "o1", UserID: "u1", Status: status, Items: []domain.OrderItem{
+		{ID: "i1", ProductID: "p1", Quantity: 2},
+		{ID: "i2", ProductID: "p2", Quantity: 1},
+	}}
+}
+
+func TestCreateShipment_PartialThenRest(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	shipments := orderdb.NewMockShipmentRepository(ctrl)
+	s, _ := NewCreateShipmentService(repo, shipments, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	first := domain.Shipment{ID: "s1", Status: domain.ShipmentShipped, Items: []domain.ShipmentItem{{OrderItemID: "i1", Quantity: 1}}}
+	shipments.EXPECT().Lock(gomock.Any(), "o1").Return(nil).Times(2)
+	shipments.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sh domain.Shipment) (domain.Shipment, error) {
+		sh.ID = "s"
+		return sh, nil
+	}).Times(2)
+	gomock.InOrder(
+		repo.EXPECT().GetByID(gomock.Any(), "o1").Return(shippableOrder(domain.StatusPaid), nil),
+		shipments.EXPECT().ListByOrder(gomock.Any(), "o1").Return(nil, nil),
+		repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusPaid, domain.StatusPartiallyShipped, gomock.Any()).Return(domain.Order{ID: "o1", Status: domain.StatusPartiallyShipped}, nil),
+		repo.EXPECT().GetByID(gomock.Any(), "o1").Return(shippableOrder(domain.StatusPartiallyShipped), nil),
+		shipments.EXPECT().ListByOrder(gomock.Any(), "o1").Return([]domain.Shipment{first}, nil),
+		repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusPartiallyShipped, domain.StatusShipped, gomock.Any()).Return(domain.Order{ID: "o1", Status: domain.StatusShipped}, nil),
+	)
+
+	if _, err := s.Create(context.Background(), "o1", domain.Shipment{Carrier: "ups", TrackingNumber: "1Z1", Items: first.Items}); err != nil {
+		t.Fatalf("first shipment: %v", err)
+	}
+
+	// no items ships what is left: one i1 and the i2
+	rest, err := s.Create(context.Background(), "o1", domain.Shipment{Carrier: "ups", TrackingNumber: "1Z2"})
+	if err != nil {
+		t.Fatalf("second shipment: %v", err)
+	}
+	want := []domain.ShipmentItem{{OrderItemID: "i1", Quantity: 1}, {OrderItemID: "i2", Quantity: 1}}
+	if rest.OrderID != "o1" || len(rest.Items) != 2 || rest.Items[0] != want[0] || rest.Items[1] != want[1] {
+		t.Fatalf("unexpected shipment: %+v", rest)
+	}
+}
+
+func TestCreateShipment_RejectsOverShipping(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	shipments := orderdb.NewMockShipmentRepository(ctrl)
+	s, _ := NewCreateShipmentService(repo, shipments, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	shipments.EXPECT().Lock(gomock.Any(), "o1").Return(nil)
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(shippableOrder(domain.StatusPartiallyShipped), nil)
+	shipments.EXPECT().ListByOrder(gomock.Any(), "o1").Return([]domain.Shipment{
+		{ID: "s1", Items: []domain.ShipmentItem{{OrderItemID: "i1", Quantity: 2}}},
+	}, nil)
+
+	_, err := s.Create(context.Background(), "o1", domain.Shipment{Carrier: "ups", TrackingNumber: "1Z3", Items: []domain.ShipmentItem{{OrderItemID: "i1", Quantity: 1}}})
+	if !errors.Is(err, domain.ErrInvalidShipment) {
+		t.Fatalf("expected ErrInvalidShipment, got %v", err)
+	}
+}
+
+func TestCreateShipment_RejectsUnpaidOrder(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	shipments := orderdb.NewMockShipmentRepository(ctrl)
+	s, _ := NewCreateShipmentService(repo, shipments, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	shipments.EXPECT().Lock(gomock.Any(), "o1").Return(nil)
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(shippableOrder(domain.StatusCreated), nil)
+	shipments.EXPECT().ListByOrder(gomock.Any(), "o1").Return(nil, nil)
+
+	_, err := s.Create(context.Background(), "o1", domain.Shipment{Carrier: "ups", TrackingNumber: "1Z4"})
+	var transitionErr domain.TransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("expected TransitionError, got %v", err)
+	}
+}
+
+func TestDeliverShipment_DeliversOrderWithLastShipment(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	shipments := orderdb.NewMockShipmentRepository(ctrl)
+	s, _ := NewDeliverShipmentService(repo, shipments, stubPublisher{}, stubTx{}, tracer)
+
+	all := []domain.ShipmentItem{{OrderItemID: "i1", Quantity: 2}, {OrderItemID: "i2", Quantity: 1}}
+	delivered := domain.Shipment{ID: "s1", OrderID: "o1", Status: domain.ShipmentDelivered, Items: all}
+	shipments.EXPECT().Lock(gomock.Any(), "o1").Return(nil).Times(2)
+	gomock.InOrder(
+		shipments.EXPECT().Get(gomock.Any(), "o1", "s1").Return(domain.Shipment{ID: "s1", OrderID: "o1", Status: domain.ShipmentShipped, Items: all}, nil),
+		shipments.EXPECT().MarkDelivered(gomock.Any(), "o1", "s1").Return(delivered, nil),
+		repo.EXPECT().GetByID(gomock.Any(), "o1").Return(shippableOrder(domain.StatusShipped), nil),
+		shipments.EXPECT().ListByOrder(gomock.Any(), "o1").Return([]domain.Shipment{delivered}, nil),
+		repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusShipped, domain.StatusDelivered, gomock.Any()).Return(domain.Order{ID: "o1", Status: domain.StatusDelivered}, nil),
+		// delivering again changes nothing
+		shipments.EXPECT().Get(gomock.Any(), "o1", "s1").Return(delivered, nil),
+	)
+
+	for i := 0; i < 2; i++ {
+		res, err := s.Deliver(context.Background(), "o1", "s1")
+		if err != nil {
+			t.Fatalf("deliver: %v", err)
+		}
+		if res.Status != domain.ShipmentDelivered {
+			t.Fatalf("unexpected shipment: %+v", res)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type DeliverShipmentService interface {
+	// Deliver marks a shipment delivered; the order moves to delivered once
+	// all its items are shipped and every shipment is delivered. Delivering
+	// a shipment twice returns it unchanged.
+	Deliver(ctx context.Context, orderID string, shipmentID string) (domain.Shipment, error)
+}
+
+type deliverShipmentService struct {
+	repo      orderdb.OrderRepository
+	shipments orderdb.ShipmentRepository
+	events    outboxcmd.PublishService
+	tx        appdb.Transactor
+	tracer    observability.Tracer
+}
+
+func NewDeliverShipmentService(r orderdb.OrderRepository, sr orderdb.ShipmentRepository, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (DeliverShipmentService, error) {
+	return &deliverShipmentService{repo: r, shipments: sr, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *deliverShipmentService) Deliver(ctx context.Context, orderID string, shipmentID string) (domain.Shipment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.DeliverShipment")
+	defer span.End()
+
+	var delivered domain.Shipment
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.shipments.Lock(ctx, orderID); err != nil {
+			return err
+		}
+		current, err := s.shipments.Get(ctx, orderID, shipmentID)
+		if err != nil {
+			return err
+		}
+		if current.Status == domain.ShipmentDelivered {
+			delivered = current
+			return nil
+		}
+
+		delivered, err = s.shipments.MarkDelivered(ctx, orderID, shipmentID)
+		if err != nil {
+			return err
+		}
+		if err := s.events.Publish(ctx, domain.TopicShipmentDelivered, delivered); err != nil {
+			return err
+		}
+
+		order, err := s.repo.GetByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		shipments, err := s.shipments.ListByOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		// a parcel still arrives after the order was refunded; the order keeps its status
+		status := domain.FulfillmentStatus(order, shipments)
+		if status == order.Status || domain.ValidateTransition(order.Status, status) != nil {
+			return nil
+		}
+		return moveStatus(ctx, s.repo, s.events, order, status, "shipment "+delivered.ID+" delivered")
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Shipment{}, err
+	}
+
+	return delivered, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/deliver_shipment.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeliverShipmentService is a mock of DeliverShipmentService interface.
+type MockDeliverShipmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliverShipmentServiceMockRecorder
+}
+
+// MockDeliverShipmentServiceMockRecorder is the mock recorder for MockDeliverShipmentService.
+type MockDeliverShipmentServiceMockRecorder struct {
+	mock *MockDeliverShipmentService
+}
+
+// NewMockDeliverShipmentService creates a new mock instance.
+func NewMockDeliverShipmentService(ctrl *gomock.Controller) *MockDeliverShipmentService {
+	mock := &MockDeliverShipmentService{ctrl: ctrl}
+	mock.recorder = &MockDeliverShipmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliverShipmentService) EXPECT() *MockDeliverShipmentServiceMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockDeliverShipmentService) Deliver(ctx context.Context, orderID, shipmentID string) (domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, orderID, shipmentID)
+	ret0, _ := ret[0].(domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockDeliverShipmentServiceMockRecorder) Deliver(ctx, orderID, shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockDeliverShipmentService)(nil).Deliver), ctx, orderID, shipmentID)
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+
+	"r2-challenge/cmd/envs"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+// expiryBatchSize bounds the orders expired by one run; the rest wait for
+// the next one.
+const expiryBatchSize = 100
+
+type ExpireUnpaidService interface {
+	// ExpireUnpaid cancels the orders still awaiting payment once the
+	// payment TTL has passed since placement, restoring their stock and
+	// coupon, and returns how many were cancelled. An order that fails is
+	// left for the next run and does not stop the others.
+	ExpireUnpaid(ctx context.Context) (int, error)
+}
+
+type expireUnpaidService struct {
+	repo       orderdb.OrderRepository
+	promotions promocmd.RedeemService
+	events     outboxcmd.PublishService
+	tx         appdb.Transactor
+	tracer     observability.Tracer
+	ttl        time.Duration
+	perRun     metric.Int64Histogram
+	now        func() time.Time
+}
+
+func NewExpireUnpaidService(r orderdb.OrderRepository, rd promocmd.RedeemService, ev outboxcmd.PublishService, tx appdb.Transactor, e envs.Envs, t observability.Tracer) (ExpireUnpaidService, error) {
+	ttl, err := time.ParseDuration(e.OrderPaymentTTL)
+	if err != nil || ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+
+	perRun, err := otel.Meter("r2-challenge").Int64Histogram("orders_expired_per_run",
+		metric.WithDescription("Unpaid orders cancelled by one run of the expiry job"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &expireUnpaidService{
+		repo:       r,
+		promotions: rd,
+		events:     ev,
+		tx:         tx,
+		tracer:     t,
+		ttl:        ttl,
+		perRun:     perRun,
+		now:        func() time.Time { return time.Now().UTC() },
+	}, nil
+}
+
+func (s *expireUnpaidService) ExpireUnpaid(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.ExpireUnpaid")
+	defer span.End()
+
+	orders, err := s.repo.ListAwaitingPayment(ctx, s.now().Add(-s.ttl), expiryBatchSize)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	expired := 0
+	var errs []error
+	for _, order := range orders {
+		err := s.expire(ctx, order)
+		switch {
+		case err == nil:
+			expired++
+		case errors.Is(err, domain.ErrStatusConflict):
+			// paid or cancelled since it was listed
+		default:
+			span.RecordError(err)
+			errs = append(errs, err)
+		}
+	}
+	s.perRun.Record(ctx, int64(expired))
+
+	return expired, errors.Join(errs...)
+}
+
+// expire cancels one order, gives back its stock and coupon and publishes
+// the change, all in one transaction.
+func (s *expireUnpaidService) expire(ctx context.Context, order domain.Order) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		cancelled, err := s.repo.Release(ctx, order.ID, domain.StatusCreated, domain.StatusCancelled, "payment not received within "+s.ttl.String())
+		if err != nil {
+			return err
+		}
+
+		if cancelled.CouponCode != "" {
+			if err := s.promotions.Release(ctx, cancelled.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+			OrderID: cancelled.ID, UserID: cancelled.UserID, From: order.Status, To: cancelled.Status,
+		}); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TopicOrderExpired, cancelled)
+	})
+}
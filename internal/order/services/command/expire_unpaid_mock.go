@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/expire_unpaid.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExpireUnpaidService is a mock of ExpireUnpaidService interface.
+type MockExpireUnpaidService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpireUnpaidServiceMockRecorder
+}
+
+// MockExpireUnpaidServiceMockRecorder is the mock recorder for MockExpireUnpaidService.
+type MockExpireUnpaidServiceMockRecorder struct {
+	mock *MockExpireUnpaidService
+}
+
+// NewMockExpireUnpaidService creates a new mock instance.
+func NewMockExpireUnpaidService(ctrl *gomock.Controller) *MockExpireUnpaidService {
+	mock := &MockExpireUnpaidService{ctrl: ctrl}
+	mock.recorder = &MockExpireUnpaidServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpireUnpaidService) EXPECT() *MockExpireUnpaidServiceMockRecorder {
+	return m.recorder
+}
+
+// ExpireUnpaid mocks base method.
+func (m *MockExpireUnpaidService) ExpireUnpaid(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireUnpaid", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireUnpaid indicates an expected call of ExpireUnpaid.
+func (mr *MockExpireUnpaidServiceMockRecorder) ExpireUnpaid(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireUnpaid", reflect.TypeOf((*MockExpireUnpaidService)(nil).ExpireUnpaid), ctx)
+}
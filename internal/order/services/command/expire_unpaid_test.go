@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"r2-challenge/cmd/envs"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+func TestExpireUnpaid_CancelsOrdersPastTTL(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	promotions := promocmd.NewMockRedeemService(ctrl)
+	svc, err := NewExpireUnpaidService(repo, promotions, stubPublisher{}, stubTx{}, envs.Envs{OrderPaymentTTL: "2h"}, tracer)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc.(*expireUnpaidService).now = func() time.Time { return now }
+
+	repo.EXPECT().ListAwaitingPayment(gomock.Any(), now.Add(-2*time.Hour), expiryBatchSize).Return([]domain.Order{
+		{ID: "o1", Status: domain.StatusCreated},
+		{ID: "o2", Status: domain.StatusCreated, CouponCode: "SAVE10"},
+		{ID: "o3", Status: domain.StatusCreated},
+	}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusCancelled, "payment not received within 2h0m0s").
+		Return(domain.Order{ID: "o1", Status: domain.StatusCancelled}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o2", domain.StatusCreated, domain.StatusCancelled, gomock.Any()).
+		Return(domain.Order{ID: "o2", Status: domain.StatusCancelled, CouponCode: "SAVE10"}, nil)
+	promotions.EXPECT().Release(gomock.Any(), "o2").Return(nil)
+	// paid in the meantime
+	repo.EXPECT().Release(gomock.Any(), "o3", domain.StatusCreated, domain.StatusCancelled, gomock.Any()).
+		Return(domain.Order{}, domain.ErrStatusConflict)
+
+	expired, err := svc.ExpireUnpaid(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if expired != 2 {
+		t.Fatalf("expected 2 expired orders, got %d", expired)
+	}
+}
+
+func TestExpireUnpaid_KeepsGoingAfterAFailure(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	svc, _ := NewExpireUnpaidService(repo, promocmd.NewMockRedeemService(ctrl), stubPublisher{}, stubTx{}, envs.Envs{}, tracer)
+
+	dbDown := errors.New("db down")
+	repo.EXPECT().ListAwaitingPayment(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Order{
+		{ID: "o1", Status: domain.StatusCreated},
+		{ID: "o2", Status: domain.StatusCreated},
+	}, nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.Order{}, dbDown)
+	repo.EXPECT().Release(gomock.Any(), "o2", gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.Order{ID: "o2", Status: domain.StatusCancelled}, nil)
+
+	expired, err := svc.ExpireUnpaid(context.Background())
+	if expired != 1 || !errors.Is(err, dbDown) {
+		t.Fatalf("expected 1 expired order and the failure, got %d, %v", expired, err)
+	}
+}
@@ -2,30 +2,64 @@ package command
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
 	orderdb "r2-challenge/internal/order/adapters/db"
-	"r2-challenge/internal/order/adapters/notification"
 	"r2-challenge/internal/order/adapters/payment"
+	"r2-challenge/internal/order/adapters/shipping"
+	"r2-challenge/internal/order/adapters/tax"
 	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
 	pmtdomain "r2-challenge/internal/payment/domain"
 	pmtcmd "r2-challenge/internal/payment/services/command"
+	promodomain "r2-challenge/internal/promotion/domain"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	userdomain "r2-challenge/internal/user/domain"
+	userqry "r2-challenge/internal/user/services/query"
+	appdb "r2-challenge/pkg/db"
 	"r2-challenge/pkg/observability"
 )
 
 type PlaceOrderService interface {
+	// Place saves the order and collects its payment. A CouponCode on the
+	// order is redeemed in the same transaction as the save; a coupon that
+	// does not apply fails with promotion ErrCouponNotApplicable. The order
+	// ships to its ShippingAddress, the address book entry named by
+	// ShippingAddressID or else the user's default address, and is taxed
+	// there; ErrInvalidShippingAddress when there is none. A declined charge
+	// releases the order and fails with ErrPaymentFailed; when the provider's
+	// answer is unknown the saved order is returned with ErrPaymentPending.
 	Place(ctx context.Context, order domain.Order) (domain.Order, error)
 }
 
 type placeOrderService struct {
 	repo        orderdb.OrderRepository
 	payments    payment.Processor
-	notifier    notification.Sender
 	paymentsSvc pmtcmd.RecordService
+	events      outboxcmd.PublishService
+	promotions  promocmd.RedeemService
+	taxes       tax.Calculator
+	shipping    shipping.Calculator
+	addresses   userqry.GetAddressService
+	tx          appdb.Transactor
+	captureMode string
 	tracer      observability.Tracer
 }
 
-func NewPlaceOrderService(r orderdb.OrderRepository, p payment.Processor, n notification.Sender, t observability.Tracer, pr pmtcmd.RecordService) (PlaceOrderService, error) {
-	return &placeOrderService{repo: r, payments: p, notifier: n, tracer: t, paymentsSvc: pr}, nil
+func NewPlaceOrderService(r orderdb.OrderRepository, p payment.Processor, t observability.Tracer, pr pmtcmd.RecordService, tx appdb.Transactor, ev outboxcmd.PublishService, rd promocmd.RedeemService, tc tax.Calculator, sc shipping.Calculator, ad userqry.GetAddressService, e envs.Envs) (PlaceOrderService, error) {
+	mode := e.PaymentCaptureMode
+	if mode == "" {
+		mode = pmtdomain.CaptureImmediate
+	}
+	if mode != pmtdomain.CaptureImmediate && mode != pmtdomain.CaptureOnShipment {
+		return nil, fmt.Errorf("unknown payment capture mode %q", mode)
+	}
+	return &placeOrderService{repo: r, payments: p, tracer: t, paymentsSvc: pr, tx: tx, events: ev, promotions: rd, taxes: tc, shipping: sc, addresses: ad, captureMode: mode}, nil
 }
 
 func (s *placeOrderService) Place(ctx context.Context, order domain.Order) (domain.Order, error) {
@@ -34,33 +68,223 @@ func (s *placeOrderService) Place(ctx context.Context, order domain.Order) (doma
 
 	// default status when not provided
 	if order.Status == "" {
-		order.Status = "created"
+		order.Status = domain.StatusCreated
 	}
-
-	saved, err := s.repo.Save(ctx, order)
+	order, err := s.destination(ctx, order)
 	if err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
 	}
 
-	receiptID, err := s.payments.Charge(ctx, saved.UserID, saved.TotalCents)
+	couponCode := order.CouponCode
+	var saved domain.Order
+	var pending pmtdomain.Payment
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		saved, err = s.repo.Save(ctx, order)
+		if err != nil {
+			return err
+		}
+		if saved, err = s.price(ctx, saved, couponCode); err != nil {
+			return err
+		}
+		// the payment is on record before the provider is asked, so an order
+		// whose charge result is lost never looks unpaid
+		pending, err = s.paymentsSvc.Record(ctx, pmtdomain.Payment{
+			OrderID:     saved.ID,
+			UserID:      saved.UserID,
+			AmountCents: saved.TotalCents,
+			Provider:    s.payments.Name(),
+			Status:      pmtdomain.StatusPending,
+		})
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TopicOrderPlaced, saved)
+	})
 	if err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
 	}
 
-	paymentRecord := pmtdomain.Payment{
-		OrderID:     saved.ID,
-		UserID:      saved.UserID,
-		AmountCents: saved.TotalCents,
-		Provider:    "mock",
-		ReceiptID:   receiptID,
-		Status:      "captured",
+	paymentRecord, err := s.collect(ctx, saved, pending)
+	if err != nil && !errors.Is(err, payment.ErrDeclined) {
+		// the provider may have charged under the order id before failing, so
+		// nothing is undone: the order stays created and its payment pending
+		// until a provider event or reconciliation settles it
+		span.RecordError(err)
+		return saved, fmt.Errorf("%w: order %s: %v", domain.ErrPaymentPending, saved.ID, err)
+	}
+	if err != nil {
+		span.RecordError(err)
+		if cerr := s.compensatePayment(ctx, saved, pending); cerr != nil {
+			span.RecordError(cerr)
+			return domain.Order{}, fmt.Errorf("%w: %v (compensation failed: %v)", domain.ErrPaymentFailed, err, cerr)
+		}
+		return domain.Order{}, fmt.Errorf("%w: %v", domain.ErrPaymentFailed, err)
 	}
 
-	_, _ = s.paymentsSvc.Record(ctx, paymentRecord)
+	topic, reference := pmtdomain.TopicPaymentCaptured, paymentRecord.ReceiptID
+	if paymentRecord.Status == pmtdomain.StatusAuthorized {
+		topic, reference = pmtdomain.TopicPaymentAuthorized, paymentRecord.AuthorizationID
+	}
 
-	_ = s.notifier.SendOrderConfirmation(ctx, "user@example.com", saved.ID)
+	// the payment result and its event commit together; side effects such as
+	// the confirmation email are delivered by the outbox dispatcher
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		recorded, err := s.paymentsSvc.Resolve(ctx, paymentRecord)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, topic, recorded)
+	})
+	if err != nil {
+		// the payment stays pending, which keeps the order from expiring
+		span.RecordError(err)
+		return domain.Order{}, fmt.Errorf("record %s payment for order %s (%s): %w", paymentRecord.Status, saved.ID, reference, err)
+	}
 
 	return saved, nil
 }
+
+// destination snapshots the shipping address of the order, taxes it there
+// and resolves its shipping method.
+func (s *placeOrderService) destination(ctx context.Context, order domain.Order) (domain.Order, error) {
+	if order.ShippingAddress == nil {
+		var a userdomain.Address
+		var err error
+		if order.ShippingAddressID != "" {
+			a, err = s.addresses.Get(ctx, order.UserID, order.ShippingAddressID)
+		} else {
+			a, err = s.addresses.Default(ctx, order.UserID)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Order{}, fmt.Errorf("%w: no such address in the address book", domain.ErrInvalidShippingAddress)
+		}
+		if err != nil {
+			return domain.Order{}, err
+		}
+		order.ShippingAddress = &domain.Address{
+			Name: a.Name, Line1: a.Line1, Line2: a.Line2, City: a.City,
+			Region: a.Region, PostalCode: a.PostalCode, Country: a.Country, Phone: a.Phone,
+		}
+	}
+
+	address := *order.ShippingAddress
+	address.Country = strings.ToUpper(strings.TrimSpace(address.Country))
+	address.Region = strings.ToUpper(strings.TrimSpace(address.Region))
+	if address.Line1 == "" || address.City == "" || len(address.Country) != 2 {
+		return domain.Order{}, domain.ErrInvalidShippingAddress
+	}
+	order.ShippingAddress = &address
+	order.TaxCountry, order.TaxRegion = address.Country, address.Region
+
+	method, err := s.shipping.Method(order.ShippingMethod)
+	if err != nil {
+		return domain.Order{}, err
+	}
+	order.ShippingMethod = method
+	return order, nil
+}
+
+// price applies the coupon, then the tax on the discounted items and the
+// shipping cost, and stores them with the order; an order with none of them
+// is returned as saved.
+func (s *placeOrderService) price(ctx context.Context, saved domain.Order, code string) (domain.Order, error) {
+	adjusted := code != ""
+	if adjusted {
+		if err := s.applyCoupon(ctx, &saved, code); err != nil {
+			return domain.Order{}, err
+		}
+	}
+
+	taxes, err := s.taxes.Calculate(ctx, saved)
+	if err != nil {
+		return domain.Order{}, err
+	}
+	for i, t := range taxes {
+		saved.Items[i].TaxRateBps, saved.Items[i].TaxCents = t.RateBps, t.Cents
+		adjusted = adjusted || t.RateBps != 0
+	}
+
+	if saved.ShippingCents, err = s.shipping.Cost(ctx, saved); err != nil {
+		return domain.Order{}, err
+	}
+	adjusted = adjusted || saved.ShippingCents != 0
+
+	if !adjusted {
+		return saved, nil
+	}
+	return s.repo.ApplyPricing(ctx, saved)
+}
+
+// applyCoupon redeems the coupon against the saved items, priced from the
+// catalog, and sets the discount of each line.
+func (s *placeOrderService) applyCoupon(ctx context.Context, saved *domain.Order, code string) error {
+	lines := make([]promodomain.Line, 0, len(saved.Items))
+	for _, it := range saved.Items {
+		lines = append(lines, promodomain.Line{ProductID: it.ProductID, Category: it.Category, Quantity: it.Quantity, UnitPriceCents: it.PriceCents})
+	}
+
+	redemption, err := s.promotions.Redeem(ctx, code, saved.UserID, saved.ID, lines)
+	if err != nil {
+		return err
+	}
+
+	saved.CouponCode = redemption.Code
+	for i := range saved.Items {
+		saved.Items[i].DiscountCents = redemption.LineDiscounts[i]
+	}
+	return nil
+}
+
+// collect charges the order total, or only authorizes it when payments are
+// captured on shipment, and returns the pending payment with the result to
+// record. The order id is the idempotency key, so the order is never charged
+// twice.
+func (s *placeOrderService) collect(ctx context.Context, saved domain.Order, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+	if s.captureMode == pmtdomain.CaptureOnShipment {
+		authorizationID, err := s.payments.Authorize(ctx, saved.ID, saved.UserID, saved.TotalCents)
+		if err != nil {
+			return pmtdomain.Payment{}, err
+		}
+		p.AuthorizationID = authorizationID
+		p.Status = pmtdomain.StatusAuthorized
+		return p, nil
+	}
+
+	receiptID, err := s.payments.Charge(ctx, saved.ID, saved.UserID, saved.TotalCents)
+	if err != nil {
+		return pmtdomain.Payment{}, err
+	}
+	p.ReceiptID = receiptID
+	p.Status = pmtdomain.StatusCaptured
+	return p, nil
+}
+
+// compensatePayment undoes a saved order whose charge was declined: the order moves
+// to payment_failed, its inventory and coupon are restored and the pending
+// payment is marked failed, all in one transaction.
+func (s *placeOrderService) compensatePayment(ctx context.Context, saved domain.Order, pending pmtdomain.Payment) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		released, err := s.repo.Release(ctx, saved.ID, saved.Status, domain.StatusPaymentFailed, "payment declined")
+		if err != nil {
+			return err
+		}
+
+		if saved.CouponCode != "" {
+			if err := s.promotions.Release(ctx, saved.ID); err != nil {
+				return err
+			}
+		}
+
+		pending.Status = pmtdomain.StatusFailed
+		if _, err := s.paymentsSvc.Resolve(ctx, pending); err != nil {
+			return err
+		}
+
+		return s.events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+			OrderID: saved.ID, UserID: saved.UserID, From: saved.Status, To: released.Status,
+		})
+	})
+}
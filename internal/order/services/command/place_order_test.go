@@ -2,22 +2,65 @@ package command
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+	"r2-challenge/cmd/envs"
 	orderdb "r2-challenge/internal/order/adapters/db"
-	notifmock "r2-challenge/internal/order/adapters/notification"
 	paymentmock "r2-challenge/internal/order/adapters/payment"
+	"r2-challenge/internal/order/adapters/shipping"
+	"r2-challenge/internal/order/adapters/tax"
 	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
 	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	promodomain "r2-challenge/internal/promotion/domain"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	userdomain "r2-challenge/internal/user/domain"
+	userqry "r2-challenge/internal/user/services/query"
 	"r2-challenge/pkg/observability"
 )
 
+var shipTo = domain.Address{Name: "Jane Doe", Line1: "1 Main St", City: "Springfield", PostalCode: "12345", Country: "US"}
+
+// freeShipping offers a single flat method that costs nothing.
+func freeShipping(t *testing.T) shipping.Calculator {
+	t.Helper()
+	c, err := shipping.NewMethodTable(map[string]shipping.Rate{shipping.MethodFlat: shipping.Flat(0)}, shipping.MethodFlat)
+	if err != nil {
+		t.Fatalf("shipping: %v", err)
+	}
+	return c
+}
+
 // stubRecordSvc matches the RecordService signature expected by PlaceOrderService.
 type stubRecordSvc struct{}
 
-func (stubRecordSvc) Record(_ context.Context, _ pmtdomain.Payment) (pmtdomain.Payment, error) {
-	return pmtdomain.Payment{}, nil
+func (stubRecordSvc) Record(_ context.Context, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+	return p, nil
+}
+
+func (stubRecordSvc) Resolve(_ context.Context, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+	return p, nil
+}
+
+// expectPending expects the pending payment recorded with the order and
+// returns it as pay_1.
+func expectPending(t *testing.T, records *pmtcmd.MockRecordService) {
+	t.Helper()
+	records.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+		if p.Status != pmtdomain.StatusPending || p.OrderID != "ord_1" || p.ReceiptID != "" {
+			t.Fatalf("unexpected pending payment: %+v", p)
+		}
+		p.ID = "pay_1"
+		return p, nil
+	})
 }
 
 func TestPlaceOrder_Success(t *testing.T) {
@@ -27,18 +70,34 @@ func TestPlaceOrder_Success(t *testing.T) {
 
 	repo := orderdb.NewMockOrderRepository(ctrl)
 	payments := paymentmock.NewMockProcessor(ctrl)
-	notifier := notifmock.NewMockSender(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	records := pmtcmd.NewMockRecordService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
 
-	s, err := NewPlaceOrderService(repo, payments, notifier, tracer, stubRecordSvc{})
+	s, err := NewPlaceOrderService(repo, payments, tracer, records, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
 	if err != nil {
 		t.Fatalf("failed to build service: %v", err)
 	}
 
-	order := domain.Order{UserID: "u1", Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1, PriceCents: 1000}}, TotalCents: 1000}
+	order := domain.Order{UserID: "u1", ShippingAddress: &shipTo, Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1, PriceCents: 1000}}, TotalCents: 1000}
 
 	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
-	payments.EXPECT().Charge(gomock.Any(), "u1", int64(1000)).Return("rcpt_x", nil)
-	notifier.EXPECT().SendOrderConfirmation(gomock.Any(), gomock.Any(), "ord_1").Return(nil)
+	expectPending(t, records)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+	// the order id keeps a repeated charge from collecting twice
+	payments.EXPECT().Charge(gomock.Any(), "ord_1", "u1", int64(1000)).Return("rcpt_x", nil)
+	records.EXPECT().Resolve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+		if p.ID != "pay_1" || p.Status != pmtdomain.StatusCaptured || p.ReceiptID != "rcpt_x" || p.OrderID != "ord_1" {
+			t.Fatalf("unexpected payment record: %+v", p)
+		}
+		return p, nil
+	})
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentCaptured, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, payload any) error {
+		if p, ok := payload.(pmtdomain.Payment); !ok || p.ID != "pay_1" {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		return nil
+	})
 
 	result, err := s.Place(context.Background(), order)
 	if err != nil {
@@ -48,3 +107,403 @@ func TestPlaceOrder_Success(t *testing.T) {
 		t.Fatalf("expected order ID")
 	}
 }
+
+func TestPlaceOrder_PublishFailureFailsSave(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, stubRecordSvc{}, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(errors.New("db down"))
+
+	if _, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, TotalCents: 1000}); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestPlaceOrder_RecordFailureIsReported(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	records := pmtcmd.NewMockRecordService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, records, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	expectPending(t, records)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+	payments.EXPECT().Charge(gomock.Any(), gomock.Any(), "u1", int64(1000)).Return("rcpt_x", nil)
+	// the order is not compensated: it was charged and its payment stays
+	// pending, which keeps the expiry job away from it
+	records.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(pmtdomain.Payment{}, errors.New("db down"))
+
+	if _, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, TotalCents: 1000}); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestPlaceOrder_ChargeFailureCompensates(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	records := pmtcmd.NewMockRecordService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, err := NewPlaceOrderService(repo, payments, tracer, records, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+	if err != nil {
+		t.Fatalf("failed to build service: %v", err)
+	}
+
+	order := domain.Order{UserID: "u1", ShippingAddress: &shipTo, Items: []domain.OrderItem{{ProductID: "p1", Quantity: 2, PriceCents: 500}}, TotalCents: 1000}
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	expectPending(t, records)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+	payments.EXPECT().Charge(gomock.Any(), gomock.Any(), "u1", int64(1000)).Return("", fmt.Errorf("%w: card declined", paymentmock.ErrDeclined))
+	repo.EXPECT().Release(gomock.Any(), "ord_1", domain.StatusCreated, domain.StatusPaymentFailed, "payment declined").Return(domain.Order{ID: "ord_1", Status: domain.StatusPaymentFailed}, nil)
+	records.EXPECT().Resolve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+		if p.ID != "pay_1" || p.Status != pmtdomain.StatusFailed || p.OrderID != "ord_1" || p.AmountCents != 1000 {
+			t.Fatalf("unexpected payment record: %+v", p)
+		}
+		return p, nil
+	})
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, domain.StatusChanged{OrderID: "ord_1", UserID: "u1", From: domain.StatusCreated, To: domain.StatusPaymentFailed}).Return(nil)
+
+	_, err = s.Place(context.Background(), order)
+	if !errors.Is(err, domain.ErrPaymentFailed) {
+		t.Fatalf("expected ErrPaymentFailed, got %v", err)
+	}
+}
+
+func TestPlaceOrder_UnknownChargeOutcomeLeavesOrderPending(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	records := pmtcmd.NewMockRecordService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, records, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	expectPending(t, records)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+	// the provider may have charged before the call failed; the mocks fail
+	// the test if the order is released or the payment resolved
+	payments.EXPECT().Charge(gomock.Any(), "ord_1", "u1", int64(1000)).Return("", context.DeadlineExceeded)
+
+	placed, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, TotalCents: 1000})
+	if !errors.Is(err, domain.ErrPaymentPending) || errors.Is(err, domain.ErrPaymentFailed) {
+		t.Fatalf("expected ErrPaymentPending, got %v", err)
+	}
+	if placed.ID != "ord_1" || placed.Status != domain.StatusCreated {
+		t.Fatalf("expected the created order, got %+v", placed)
+	}
+}
+
+func TestPlaceOrder_GatewayFailureIsNotADecline(t *testing.T) {
+	for name, handler := range map[string]http.HandlerFunc{
+		"5xx": func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		},
+		"timeout": func(w http.ResponseWriter, _ *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(handler)
+			t.Cleanup(srv.Close)
+
+			tracer, _ := observability.SetupTracer()
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			repo := orderdb.NewMockOrderRepository(ctrl)
+			records := pmtcmd.NewMockRecordService(ctrl)
+			events := outboxcmd.NewMockPublishService(ctrl)
+			gateway, err := paymentmock.NewGatewayProcessor(paymentmock.GatewayConfig{BaseURL: srv.URL, Timeout: 50 * time.Millisecond, MaxRetries: 1, RetryBackoff: time.Millisecond})
+			if err != nil {
+				t.Fatalf("gateway: %v", err)
+			}
+
+			s, _ := NewPlaceOrderService(repo, gateway, tracer, records, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+			repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+			expectPending(t, records)
+			events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+			// no Release and no Resolve: the order keeps its stock and its
+			// payment stays pending
+
+			_, err = s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, TotalCents: 1000})
+			if !errors.Is(err, domain.ErrPaymentPending) {
+				t.Fatalf("expected ErrPaymentPending, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPlaceOrder_CompensationFailureIsReported(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, stubRecordSvc{}, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+	order := domain.Order{UserID: "u1", ShippingAddress: &shipTo, TotalCents: 1000}
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+	payments.EXPECT().Charge(gomock.Any(), gomock.Any(), "u1", int64(1000)).Return("", fmt.Errorf("%w: card declined", paymentmock.ErrDeclined))
+	repo.EXPECT().Release(gomock.Any(), "ord_1", domain.StatusCreated, domain.StatusPaymentFailed, "payment declined").Return(domain.Order{}, errors.New("db down"))
+
+	_, err := s.Place(context.Background(), order)
+	if !errors.Is(err, domain.ErrPaymentFailed) {
+		t.Fatalf("expected ErrPaymentFailed, got %v", err)
+	}
+}
+
+func TestPlaceOrder_OnShipmentOnlyAuthorizes(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	records := pmtcmd.NewMockRecordService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+
+	s, err := NewPlaceOrderService(repo, payments, tracer, records, stubTx{}, events, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{PaymentCaptureMode: pmtdomain.CaptureOnShipment})
+	if err != nil {
+		t.Fatalf("failed to build service: %v", err)
+	}
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	expectPending(t, records)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).Return(nil)
+	payments.EXPECT().Authorize(gomock.Any(), "ord_1", "u1", int64(1000)).Return("auth_1", nil)
+	records.EXPECT().Resolve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p pmtdomain.Payment) (pmtdomain.Payment, error) {
+		if p.Status != pmtdomain.StatusAuthorized || p.AuthorizationID != "auth_1" || p.ReceiptID != "" {
+			t.Fatalf("unexpected payment record: %+v", p)
+		}
+		return p, nil
+	})
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentAuthorized, gomock.Any()).Return(nil)
+
+	if _, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, TotalCents: 1000}); err != nil {
+		t.Fatalf("Place failed: %v", err)
+	}
+}
+
+func TestPlaceOrder_RejectsUnknownCaptureMode(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+
+	if _, err := NewPlaceOrderService(nil, nil, tracer, stubRecordSvc{}, stubTx{}, stubPublisher{}, nil, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{PaymentCaptureMode: "later"}); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestPlaceOrder_CouponDiscountsChargedTotal(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	events := outboxcmd.NewMockPublishService(ctrl)
+	promotions := promocmd.NewMockRedeemService(ctrl)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, stubRecordSvc{}, stubTx{}, events, promotions, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+	order := domain.Order{UserID: "u1", ShippingAddress: &shipTo, CouponCode: "save10", Items: []domain.OrderItem{{ProductID: "p1", Quantity: 2}, {ProductID: "p2", Quantity: 1}}}
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) {
+		o.ID = "ord_1"
+		o.Items[0].ID, o.Items[0].PriceCents = "i1", 500
+		o.Items[1].ID, o.Items[1].PriceCents = "i2", 1000
+		o.SubtotalCents, o.TotalCents = 2000, 2000
+		return o, nil
+	})
+	promotions.EXPECT().Redeem(gomock.Any(), "save10", "u1", "ord_1", []promodomain.Line{
+		{ProductID: "p1", Quantity: 2, UnitPriceCents: 500},
+		{ProductID: "p2", Quantity: 1, UnitPriceCents: 1000},
+	}).Return(promodomain.Redemption{Code: "SAVE10", DiscountCents: 200, LineDiscounts: []int64{100, 100}}, nil)
+	repo.EXPECT().ApplyPricing(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) {
+		if o.CouponCode != "SAVE10" || o.Items[0].DiscountCents != 100 || o.Items[1].DiscountCents != 100 {
+			t.Fatalf("unexpected discount: %+v", o)
+		}
+		o.DiscountCents, o.TotalCents = 200, 1800
+		return o, nil
+	})
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderPlaced, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, payload any) error {
+		if o, ok := payload.(domain.Order); !ok || o.TotalCents != 1800 {
+			t.Fatalf("order.placed should carry the discounted order: %+v", payload)
+		}
+		return nil
+	})
+	payments.EXPECT().Charge(gomock.Any(), gomock.Any(), "u1", int64(1800)).Return("rcpt_x", nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentCaptured, gomock.Any()).Return(nil)
+
+	placed, err := s.Place(context.Background(), order)
+	if err != nil {
+		t.Fatalf("Place failed: %v", err)
+	}
+	if placed.TotalCents != 1800 || placed.DiscountCents != 200 {
+		t.Fatalf("unexpected order: %+v", placed)
+	}
+}
+
+func TestPlaceOrder_CouponNotApplicableFailsPlacement(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	promotions := promocmd.NewMockRedeemService(ctrl)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, stubRecordSvc{}, stubTx{}, stubPublisher{}, promotions, tax.NewRateTable(nil), freeShipping(t), nil, envs.Envs{})
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) { o.ID = "ord_1"; return o, nil })
+	promotions.EXPECT().Redeem(gomock.Any(), "EXPIRED", "u1", "ord_1", gomock.Any()).Return(promodomain.Redemption{}, promodomain.ErrCouponNotApplicable)
+
+	_, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, CouponCode: "EXPIRED"})
+	if !errors.Is(err, promodomain.ErrCouponNotApplicable) {
+		t.Fatalf("expected ErrCouponNotApplicable, got %v", err)
+	}
+}
+
+func TestPlaceOrder_TaxesDiscountedItems(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	promotions := promocmd.NewMockRedeemService(ctrl)
+	taxes := tax.NewRateTable([]tax.Rate{{Country: "US", Region: "CA", Bps: 1000}, {Country: "US", Region: "CA", Category: "groceries", Bps: 0}})
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, stubRecordSvc{}, stubTx{}, stubPublisher{}, promotions, taxes, freeShipping(t), nil, envs.Envs{})
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) {
+		if o.TaxCountry != "US" || o.TaxRegion != "CA" {
+			t.Fatalf("tax location should be normalized: %+v", o)
+		}
+		o.ID = "ord_1"
+		o.Items[0].ID, o.Items[0].PriceCents, o.Items[0].Category = "i1", 1000, "books"
+		o.Items[1].ID, o.Items[1].PriceCents, o.Items[1].Category = "i2", 500, "groceries"
+		o.SubtotalCents, o.TotalCents = 1500, 1500
+		return o, nil
+	})
+	promotions.EXPECT().Redeem(gomock.Any(), "TENOFF", "u1", "ord_1", gomock.Any()).
+		Return(promodomain.Redemption{Code: "TENOFF", DiscountCents: 150, LineDiscounts: []int64{100, 50}}, nil)
+	repo.EXPECT().ApplyPricing(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) {
+		// 10% of the discounted 900 on books, groceries are exempt
+		if o.Items[0].TaxRateBps != 1000 || o.Items[0].TaxCents != 90 || o.Items[1].TaxCents != 0 {
+			t.Fatalf("unexpected taxes: %+v", o.Items)
+		}
+		o.DiscountCents, o.TaxCents, o.TotalCents = 150, 90, 1440
+		return o, nil
+	})
+	payments.EXPECT().Charge(gomock.Any(), gomock.Any(), "u1", int64(1440)).Return("rcpt_x", nil)
+
+	placed, err := s.Place(context.Background(), domain.Order{UserID: "u1", CouponCode: "TENOFF", ShippingAddress: &domain.Address{Name: "Jane Doe", Line1: "1 Main St", City: "Fresno", PostalCode: "93650", Country: "us", Region: " ca"}, Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1}, {ProductID: "p2", Quantity: 1}}})
+	if err != nil {
+		t.Fatalf("Place failed: %v", err)
+	}
+	if placed.TotalCents != 1440 || placed.TaxCents != 90 {
+		t.Fatalf("unexpected order: %+v", placed)
+	}
+}
+
+func TestPlaceOrder_ShipsToAddressBookEntry(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	payments := paymentmock.NewMockProcessor(ctrl)
+	payments.EXPECT().Name().Return("mock").AnyTimes()
+	addresses := userqry.NewMockGetAddressService(ctrl)
+	rates, _ := shipping.NewMethodTable(map[string]shipping.Rate{
+		shipping.MethodFlat:   shipping.Flat(500),
+		shipping.MethodWeight: shipping.ByWeight(300, 200),
+	}, shipping.MethodFlat)
+
+	s, _ := NewPlaceOrderService(repo, payments, tracer, stubRecordSvc{}, stubTx{}, stubPublisher{}, nil, tax.NewRateTable(nil), rates, addresses, envs.Envs{})
+
+	addresses.EXPECT().Get(gomock.Any(), "u1", "addr_1").Return(userdomain.Address{
+		ID: "addr_1", UserID: "u1", Name: "Jane Doe", Line1: "1 Main St", City: "Toronto", Region: "on", PostalCode: "M5V", Country: "ca",
+	}, nil)
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) {
+		if o.ShippingAddress == nil || o.ShippingAddress.City != "Toronto" || o.TaxCountry != "CA" || o.TaxRegion != "ON" || o.ShippingMethod != shipping.MethodWeight {
+			t.Fatalf("unexpected destination: %+v", o)
+		}
+		o.ID = "ord_1"
+		o.Items[0].PriceCents, o.Items[0].WeightGrams = 1000, 1500
+		o.SubtotalCents, o.TotalCents = 1000, 1000
+		return o, nil
+	})
+	repo.EXPECT().ApplyPricing(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, o domain.Order) (domain.Order, error) {
+		// 300 + 2 started kilograms
+		if o.ShippingCents != 700 {
+			t.Fatalf("unexpected shipping: %d", o.ShippingCents)
+		}
+		o.TotalCents = 1700
+		return o, nil
+	})
+	payments.EXPECT().Charge(gomock.Any(), gomock.Any(), "u1", int64(1700)).Return("rcpt_x", nil)
+
+	order := domain.Order{UserID: "u1", ShippingAddressID: "addr_1", ShippingMethod: shipping.MethodWeight, Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1}}}
+	if _, err := s.Place(context.Background(), order); err != nil {
+		t.Fatalf("Place failed: %v", err)
+	}
+}
+
+func TestPlaceOrder_RejectsMissingAddressAndUnknownMethod(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	addresses := userqry.NewMockGetAddressService(ctrl)
+	s, _ := NewPlaceOrderService(nil, nil, tracer, stubRecordSvc{}, stubTx{}, stubPublisher{}, nil, tax.NewRateTable(nil), freeShipping(t), addresses, envs.Envs{})
+
+	// nothing is saved in either case
+	addresses.EXPECT().Default(gomock.Any(), "u1").Return(userdomain.Address{}, gorm.ErrRecordNotFound)
+	if _, err := s.Place(context.Background(), domain.Order{UserID: "u1"}); !errors.Is(err, domain.ErrInvalidShippingAddress) {
+		t.Fatalf("expected ErrInvalidShippingAddress, got %v", err)
+	}
+	if _, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &domain.Address{Name: "Jane", Line1: "1 Main St", City: "X"}}); !errors.Is(err, domain.ErrInvalidShippingAddress) {
+		t.Fatalf("expected ErrInvalidShippingAddress without a country, got %v", err)
+	}
+	if _, err := s.Place(context.Background(), domain.Order{UserID: "u1", ShippingAddress: &shipTo, ShippingMethod: "drone"}); !errors.Is(err, shipping.ErrUnknownMethod) {
+		t.Fatalf("expected ErrUnknownMethod, got %v", err)
+	}
+}
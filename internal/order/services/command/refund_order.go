@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type RefundOrderService interface {
+	// Refund returns amountCents of the order's captured payments to the
+	// customer; zero refunds everything still refundable. Once nothing is
+	// left the order moves to refunded when its lifecycle allows it; an order
+	// still created is released instead, as cancelled with its stock and
+	// coupon returned. The refunds are recorded here and sent to the
+	// processor once they commit.
+	Refund(ctx context.Context, orderID string, amountCents int64, reason string) (pmtdomain.RefundResult, error)
+}
+
+type refundOrderService struct {
+	repo       orderdb.OrderRepository
+	refundsSvc pmtcmd.RefundService
+	promotions promocmd.RedeemService
+	events     outboxcmd.PublishService
+	tx         appdb.Transactor
+	tracer     observability.Tracer
+}
+
+func NewRefundOrderService(r orderdb.OrderRepository, rs pmtcmd.RefundService, rd promocmd.RedeemService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (RefundOrderService, error) {
+	return &refundOrderService{repo: r, refundsSvc: rs, promotions: rd, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *refundOrderService) Refund(ctx context.Context, orderID string, amountCents int64, reason string) (pmtdomain.RefundResult, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.Refund")
+	defer span.End()
+
+	current, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.RefundResult{}, err
+	}
+
+	var result pmtdomain.RefundResult
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.refundsSvc.Refund(ctx, orderID, amountCents, reason)
+		if err != nil {
+			return err
+		}
+
+		if err := publishRefunds(ctx, s.events, result.Refunds); err != nil {
+			return err
+		}
+
+		if result.RemainingCents > 0 {
+			return nil
+		}
+		var refunded domain.Order
+		switch {
+		case current.Status == domain.StatusCreated:
+			// a captured order that never moved to paid cannot be refunded,
+			// so it is released like any other order that will not be paid
+			refunded, err = s.repo.Release(ctx, orderID, current.Status, domain.StatusCancelled, reason)
+			if err == nil && refunded.CouponCode != "" {
+				err = s.promotions.Release(ctx, refunded.ID)
+			}
+		case domain.ValidateTransition(current.Status, domain.StatusRefunded) == nil:
+			refunded, err = s.repo.UpdateStatus(ctx, orderID, current.Status, domain.StatusRefunded, reason)
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+			OrderID: refunded.ID, UserID: refunded.UserID, From: current.Status, To: refunded.Status,
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.RefundResult{}, err
+	}
+
+	return result, nil
+}
+
+// publishRefunds publishes recorded refunds in the caller's transaction. The
+// processor pays them back only after they commit, through the
+// payment-refunds outbox subscriber (SendRefundService), so a rollback can
+// never leave money returned without a refund on record.
+func publishRefunds(ctx context.Context, events outboxcmd.PublishService, refunds []pmtdomain.Refund) error {
+	for _, r := range refunds {
+		if err := events.Publish(ctx, pmtdomain.TopicPaymentRefunded, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/refund_order.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRefundOrderService is a mock of RefundOrderService interface.
+type MockRefundOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundOrderServiceMockRecorder
+}
+
+// MockRefundOrderServiceMockRecorder is the mock recorder for MockRefundOrderService.
+type MockRefundOrderServiceMockRecorder struct {
+	mock *MockRefundOrderService
+}
+
+// NewMockRefundOrderService creates a new mock instance.
+func NewMockRefundOrderService(ctrl *gomock.Controller) *MockRefundOrderService {
+	mock := &MockRefundOrderService{ctrl: ctrl}
+	mock.recorder = &MockRefundOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundOrderService) EXPECT() *MockRefundOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// Refund mocks base method.
+func (m *MockRefundOrderService) Refund(ctx context.Context, orderID string, amountCents int64, reason string) (domain.RefundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, orderID, amountCents, reason)
+	ret0, _ := ret[0].(domain.RefundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockRefundOrderServiceMockRecorder) Refund(ctx, orderID, amountCents, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockRefundOrderService)(nil).Refund), ctx, orderID, amountCents, reason)
+}
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	promocmd "r2-challenge/internal/promotion/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+func TestRefundOrder_PartialKeepsStatus(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewRefundOrderService(repo, refunds, promocmd.NewMockRedeemService(ctrl), events, stubTx{}, tracer)
+
+	refund := pmtdomain.Refund{ID: "rf_1", ReceiptID: "rcpt_1", AmountCents: 300}
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusDelivered}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(300), "damaged").Return(pmtdomain.RefundResult{
+		Refunds:        []pmtdomain.Refund{refund},
+		RemainingCents: 700,
+	}, nil)
+	// the refund is only published; the processor is called after commit
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentRefunded, refund).Return(nil)
+
+	res, err := s.Refund(context.Background(), "o1", 300, "damaged")
+	if err != nil {
+		t.Fatalf("Refund failed: %v", err)
+	}
+	if res.RemainingCents != 700 {
+		t.Fatalf("unexpected remaining: %d", res.RemainingCents)
+	}
+}
+
+func TestRefundOrder_FullMovesOrderToRefunded(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	s, _ := NewRefundOrderService(repo, refunds, promocmd.NewMockRedeemService(ctrl), stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusDelivered}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(0), "").Return(pmtdomain.RefundResult{
+		Refunds: []pmtdomain.Refund{{ReceiptID: "rcpt_1", AmountCents: 1000}},
+	}, nil)
+	repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusDelivered, domain.StatusRefunded, "").Return(domain.Order{ID: "o1", Status: domain.StatusRefunded}, nil)
+
+	if _, err := s.Refund(context.Background(), "o1", 0, ""); err != nil {
+		t.Fatalf("Refund failed: %v", err)
+	}
+}
+
+func TestRefundOrder_FullReleasesCreatedOrder(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	promotions := promocmd.NewMockRedeemService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewRefundOrderService(repo, refunds, promotions, events, stubTx{}, tracer)
+
+	// a charge captured at checkout leaves the order created
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCreated, CouponCode: "SAVE10"}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(0), "changed mind").Return(pmtdomain.RefundResult{
+		Refunds: []pmtdomain.Refund{{ID: "rf_1", ReceiptID: "rcpt_1", AmountCents: 1000}},
+	}, nil)
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentRefunded, gomock.Any()).Return(nil)
+	repo.EXPECT().Release(gomock.Any(), "o1", domain.StatusCreated, domain.StatusCancelled, "changed mind").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusCancelled, CouponCode: "SAVE10"}, nil)
+	promotions.EXPECT().Release(gomock.Any(), "o1").Return(nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, domain.StatusChanged{OrderID: "o1", UserID: "u1", From: domain.StatusCreated, To: domain.StatusCancelled}).Return(nil)
+
+	if _, err := s.Refund(context.Background(), "o1", 0, "changed mind"); err != nil {
+		t.Fatalf("Refund failed: %v", err)
+	}
+}
+
+func TestRefundOrder_ExceedingCapturedFails(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	refunds := pmtcmd.NewMockRefundService(ctrl)
+	s, _ := NewRefundOrderService(repo, refunds, promocmd.NewMockRedeemService(ctrl), stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusPaid}, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(5000), "").Return(pmtdomain.RefundResult{}, pmtdomain.ErrRefundExceedsCaptured)
+
+	_, err := s.Refund(context.Background(), "o1", 5000, "")
+	if !errors.Is(err, pmtdomain.ErrRefundExceedsCaptured) {
+		t.Fatalf("expected ErrRefundExceedsCaptured, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/adapters/notification"
+	userdb "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/pkg/observability"
+)
+
+type SendConfirmationService interface {
+	// Send emails the order confirmation to the address on the owner's user record.
+	Send(ctx context.Context, orderID string) error
+}
+
+type sendConfirmationService struct {
+	repo     orderdb.OrderRepository
+	users    userdb.UserRepository
+	notifier notification.Sender
+	tracer   observability.Tracer
+}
+
+func NewSendConfirmationService(r orderdb.OrderRepository, u userdb.UserRepository, n notification.Sender, t observability.Tracer) (SendConfirmationService, error) {
+	return &sendConfirmationService{repo: r, users: u, notifier: n, tracer: t}, nil
+}
+
+func (s *sendConfirmationService) Send(ctx context.Context, orderID string) error {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.SendConfirmation")
+	defer span.End()
+
+	order, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	user, err := s.users.GetByID(ctx, order.UserID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := s.notifier.SendOrderConfirmation(ctx, user.Email, order); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/send_confirmation.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSendConfirmationService is a mock of SendConfirmationService interface.
+type MockSendConfirmationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendConfirmationServiceMockRecorder
+}
+
+// MockSendConfirmationServiceMockRecorder is the mock recorder for MockSendConfirmationService.
+type MockSendConfirmationServiceMockRecorder struct {
+	mock *MockSendConfirmationService
+}
+
+// NewMockSendConfirmationService creates a new mock instance.
+func NewMockSendConfirmationService(ctrl *gomock.Controller) *MockSendConfirmationService {
+	mock := &MockSendConfirmationService{ctrl: ctrl}
+	mock.recorder = &MockSendConfirmationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSendConfirmationService) EXPECT() *MockSendConfirmationServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSendConfirmationService) Send(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSendConfirmationServiceMockRecorder) Send(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSendConfirmationService)(nil).Send), ctx, orderID)
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	notifmock "r2-challenge/internal/order/adapters/notification"
+	"r2-challenge/internal/order/domain"
+	userdb "r2-challenge/internal/user/adapters/db"
+	userdomain "r2-challenge/internal/user/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestSendConfirmation_UsesOwnerEmail(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	users := userdb.NewMockUserRepository(ctrl)
+	notifier := notifmock.NewMockSender(ctrl)
+
+	s, err := NewSendConfirmationService(repo, users, notifier, tracer)
+	if err != nil {
+		t.Fatalf("failed to build service: %v", err)
+	}
+
+	order := domain.Order{ID: "ord_1", UserID: "u1", Items: []domain.OrderItem{{ProductID: "p1", Quantity: 1, PriceCents: 1000}}, TotalCents: 1000}
+	repo.EXPECT().GetByID(gomock.Any(), "ord_1").Return(order, nil)
+	users.EXPECT().GetByID(gomock.Any(), "u1").Return(userdomain.User{ID: "u1", Email: "jane@example.com"}, nil)
+	notifier.EXPECT().SendOrderConfirmation(gomock.Any(), "jane@example.com", order).Return(nil)
+
+	if err := s.Send(context.Background(), "ord_1"); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+}
+
+func TestSendConfirmation_SenderErrorIsReturned(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	users := userdb.NewMockUserRepository(ctrl)
+	notifier := notifmock.NewMockSender(ctrl)
+
+	s, _ := NewSendConfirmationService(repo, users, notifier, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "ord_1").Return(domain.Order{ID: "ord_1", UserID: "u1"}, nil)
+	users.EXPECT().GetByID(gomock.Any(), "u1").Return(userdomain.User{ID: "u1", Email: "jane@example.com"}, nil)
+	notifier.EXPECT().SendOrderConfirmation(gomock.Any(), "jane@example.com", gomock.Any()).Return(errors.New("smtp down"))
+
+	if err := s.Send(context.Background(), "ord_1"); err == nil {
+		t.Fatalf("expected error so the outbox retries")
+	}
+}
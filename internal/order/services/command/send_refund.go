@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+
+	"r2-challenge/internal/order/adapters/payment"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type SendRefundService interface {
+	// Send asks the processor to pay back a recorded refund. The refund id is
+	// the idempotency key, so repeated deliveries pay it back once.
+	Send(ctx context.Context, refund pmtdomain.Refund) error
+}
+
+type sendRefundService struct {
+	payments payment.Processor
+	tracer   observability.Tracer
+}
+
+func NewSendRefundService(p payment.Processor, t observability.Tracer) (SendRefundService, error) {
+	return &sendRefundService{payments: p, tracer: t}, nil
+}
+
+func (s *sendRefundService) Send(ctx context.Context, refund pmtdomain.Refund) error {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.SendRefund")
+	defer span.End()
+
+	if err := s.payments.Refund(ctx, refund.ID, refund.ReceiptID, refund.AmountCents); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
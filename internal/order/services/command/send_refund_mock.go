@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/send_refund.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSendRefundService is a mock of SendRefundService interface.
+type MockSendRefundService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendRefundServiceMockRecorder
+}
+
+// MockSendRefundServiceMockRecorder is the mock recorder for MockSendRefundService.
+type MockSendRefundServiceMockRecorder struct {
+	mock *MockSendRefundService
+}
+
+// NewMockSendRefundService creates a new mock instance.
+func NewMockSendRefundService(ctrl *gomock.Controller) *MockSendRefundService {
+	mock := &MockSendRefundService{ctrl: ctrl}
+	mock.recorder = &MockSendRefundServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSendRefundService) EXPECT() *MockSendRefundServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSendRefundService) Send(ctx context.Context, refund domain.Refund) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, refund)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSendRefundServiceMockRecorder) Send(ctx, refund interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSendRefundService)(nil).Send), ctx, refund)
+}
@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	paymentmock "r2-challenge/internal/order/adapters/payment"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestSendRefund_UsesRefundIDAsIdempotencyKey(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	payments := paymentmock.NewMockProcessor(ctrl)
+	s, _ := NewSendRefundService(payments, tracer)
+	refund := pmtdomain.Refund{ID: "rf_1", ReceiptID: "rcpt_1", AmountCents: 300}
+
+	// a redelivered event sends the same key, so the processor pays back once
+	payments.EXPECT().Refund(gomock.Any(), "rf_1", "rcpt_1", int64(300)).Return(nil).Times(2)
+	for i := 0; i < 2; i++ {
+		if err := s.Send(context.Background(), refund); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+
+	// a failure is returned so the outbox retries the event
+	payments.EXPECT().Refund(gomock.Any(), "rf_1", "rcpt_1", int64(300)).Return(errors.New("gateway down"))
+	if err := s.Send(context.Background(), refund); err == nil {
+		t.Fatalf("expected error")
+	}
+}
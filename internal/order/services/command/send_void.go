@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+
+	"r2-challenge/internal/order/adapters/payment"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type SendVoidService interface {
+	// Send asks the processor to release a payment recorded as voided. The
+	// processor keys the void by authorization, so repeated deliveries
+	// release it once.
+	Send(ctx context.Context, voided pmtdomain.Payment) error
+}
+
+type sendVoidService struct {
+	payments payment.Processor
+	tracer   observability.Tracer
+}
+
+func NewSendVoidService(p payment.Processor, t observability.Tracer) (SendVoidService, error) {
+	return &sendVoidService{payments: p, tracer: t}, nil
+}
+
+func (s *sendVoidService) Send(ctx context.Context, voided pmtdomain.Payment) error {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.SendVoid")
+	defer span.End()
+
+	if err := s.payments.Void(ctx, voided.AuthorizationID); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/command/send_void.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSendVoidService is a mock of SendVoidService interface.
+type MockSendVoidService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendVoidServiceMockRecorder
+}
+
+// MockSendVoidServiceMockRecorder is the mock recorder for MockSendVoidService.
+type MockSendVoidServiceMockRecorder struct {
+	mock *MockSendVoidService
+}
+
+// NewMockSendVoidService creates a new mock instance.
+func NewMockSendVoidService(ctrl *gomock.Controller) *MockSendVoidService {
+	mock := &MockSendVoidService{ctrl: ctrl}
+	mock.recorder = &MockSendVoidServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSendVoidService) EXPECT() *MockSendVoidServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockSendVoidService) Send(ctx context.Context, voided domain.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, voided)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSendVoidServiceMockRecorder) Send(ctx, voided interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSendVoidService)(nil).Send), ctx, voided)
+}
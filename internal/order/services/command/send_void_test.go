@@ -0,0 +1,33 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	paymentmock "r2-challenge/internal/order/adapters/payment"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestSendVoid_ReleasesAuthorization(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	payments := paymentmock.NewMockProcessor(ctrl)
+	s, _ := NewSendVoidService(payments, tracer)
+	voided := pmtdomain.Payment{ID: "p1", AuthorizationID: "auth_1", Status: pmtdomain.StatusVoided}
+
+	payments.EXPECT().Void(gomock.Any(), "auth_1").Return(nil)
+	if err := s.Send(context.Background(), voided); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	// a failure is returned so the outbox retries the event
+	payments.EXPECT().Void(gomock.Any(), "auth_1").Return(errors.New("gateway down"))
+	if err := s.Send(context.Background(), voided); err == nil {
+		t.Fatalf("expected error")
+	}
+}
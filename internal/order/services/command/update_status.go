@@ -5,27 +5,72 @@ import (
 
 	repo "r2-challenge/internal/order/adapters/db"
 	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	appdb "r2-challenge/pkg/db"
 	"r2-challenge/pkg/observability"
 )
 
 type UpdateStatusService interface {
-	UpdateStatus(ctx context.Context, orderID string, status string) (domain.Order, error)
+	// UpdateStatus moves the order to status; reason is kept on the order's
+	// timeline and may be empty. Cancelled, refunded and payment_failed fail
+	// with domain.ErrStatusNotSettable: their own flows restock the order,
+	// settle its payments and release its coupon.
+	UpdateStatus(ctx context.Context, orderID string, status string, reason string) (domain.Order, error)
 }
 
 type updateStatusService struct {
-	repo   repo.OrderRepository
-	tracer observability.Tracer
+	repo           repo.OrderRepository
+	authorizations pmtcmd.AuthorizationService
+	events         outboxcmd.PublishService
+	tx             appdb.Transactor
+	tracer         observability.Tracer
 }
 
-func NewUpdateStatusService(r repo.OrderRepository, t observability.Tracer) (UpdateStatusService, error) {
-	return &updateStatusService{repo: r, tracer: t}, nil
+func NewUpdateStatusService(r repo.OrderRepository, as pmtcmd.AuthorizationService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (UpdateStatusService, error) {
+	return &updateStatusService{repo: r, authorizations: as, events: ev, tx: tx, tracer: t}, nil
 }
 
-func (s *updateStatusService) UpdateStatus(ctx context.Context, orderID string, status string) (domain.Order, error) {
+func (s *updateStatusService) UpdateStatus(ctx context.Context, orderID string, status string, reason string) (domain.Order, error) {
 	ctx, span := s.tracer.StartSpan(ctx, "OrderCommand.UpdateStatus")
 	defer span.End()
 
-	order, err := s.repo.UpdateStatus(ctx, orderID, status)
+	if err := domain.ValidateDirectStatus(status); err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
+	}
+
+	current, err := s.repo.GetByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
+	}
+
+	if err := domain.ValidateTransition(current.Status, status); err != nil {
+		span.RecordError(err)
+		return domain.Order{}, err
+	}
+
+	var order domain.Order
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		order, err = s.repo.UpdateStatus(ctx, orderID, current.Status, status, reason)
+		if err != nil {
+			return err
+		}
+
+		// authorized payments are collected once the order ships
+		if status == domain.StatusShipped || status == domain.StatusPartiallyShipped {
+			if err := requestCaptures(ctx, s.authorizations, s.events, orderID); err != nil {
+				return err
+			}
+		}
+
+		return s.events.Publish(ctx, domain.TopicOrderStatusChanged, domain.StatusChanged{
+			OrderID: order.ID, UserID: order.UserID, From: current.Status, To: order.Status,
+		})
+	})
 	if err != nil {
 		span.RecordError(err)
 		return domain.Order{}, err
@@ -33,3 +78,23 @@ func (s *updateStatusService) UpdateStatus(ctx context.Context, orderID string,
 
 	return order, nil
 }
+
+// requestCaptures publishes payment.capture_requested for every authorized
+// payment of the order. The processor captures them only after the caller's
+// transaction commits, through the payment-captures outbox subscriber
+// (CapturePaymentService), so a rollback can never leave money collected
+// with the payment still on record as authorized.
+func requestCaptures(ctx context.Context, authorizations pmtcmd.AuthorizationService, events outboxcmd.PublishService, orderID string) error {
+	authorized, err := authorizations.Authorized(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range authorized {
+		if err := events.Publish(ctx, pmtdomain.TopicPaymentCaptureRequested, p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
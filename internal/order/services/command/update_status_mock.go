@@ -36,16 +36,16 @@ func (m *MockUpdateStatusService) EXPECT() *MockUpdateStatusServiceMockRecorder
 }
 
 // UpdateStatus mocks base method.
-func (m *MockUpdateStatusService) UpdateStatus(ctx context.Context, orderID, status string) (domain.Order, error) {
+func (m *MockUpdateStatusService) UpdateStatus(ctx context.Context, orderID, status, reason string) (domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, status, reason)
 	ret0, _ := ret[0].(domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockUpdateStatusServiceMockRecorder) UpdateStatus(ctx, orderID, status interface{}) *gomock.Call {
+func (mr *MockUpdateStatusServiceMockRecorder) UpdateStatus(ctx, orderID, status, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUpdateStatusService)(nil).UpdateStatus), ctx, orderID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUpdateStatusService)(nil).UpdateStatus), ctx, orderID, status, reason)
 }
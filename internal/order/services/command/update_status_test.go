@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdb "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	pmtcmd "r2-challenge/internal/payment/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+func TestUpdateStatus_AllowedTransition(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewUpdateStatusService(repo, noAuthorizations{}, events, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusCreated}, nil)
+	repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusCreated, domain.StatusPaid, "").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusPaid}, nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, domain.StatusChanged{OrderID: "o1", UserID: "u1", From: domain.StatusCreated, To: domain.StatusPaid}).Return(nil)
+
+	res, err := s.UpdateStatus(context.Background(), "o1", domain.StatusPaid, "")
+	if err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+	if res.Status != domain.StatusPaid {
+		t.Fatalf("unexpected status: %s", res.Status)
+	}
+}
+
+func TestUpdateStatus_RejectsInvalidTransition(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	s, _ := NewUpdateStatusService(repo, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusCreated}, nil)
+
+	_, err := s.UpdateStatus(context.Background(), "o1", domain.StatusDelivered, "")
+	var transitionErr domain.TransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("expected TransitionError, got %v", err)
+	}
+}
+
+func TestUpdateStatus_RejectsUnknownStatus(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	s, _ := NewUpdateStatusService(repo, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusCreated}, nil)
+
+	if _, err := s.UpdateStatus(context.Background(), "o1", "teleported", ""); !errors.Is(err, domain.ErrUnknownStatus) {
+		t.Fatalf("expected ErrUnknownStatus, got %v", err)
+	}
+}
+
+func TestUpdateStatus_RejectsStatusesWithTheirOwnFlow(t *testing.T) {
+	for _, status := range []string{domain.StatusCancelled, domain.StatusRefunded, domain.StatusPaymentFailed} {
+		t.Run(status, func(t *testing.T) {
+			tracer, _ := observability.SetupTracer()
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			// nothing is read or written: the repository mock expects no call
+			repo := orderdb.NewMockOrderRepository(ctrl)
+			s, _ := NewUpdateStatusService(repo, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+			if _, err := s.UpdateStatus(context.Background(), "o1", status, ""); !errors.Is(err, domain.ErrStatusNotSettable) {
+				t.Fatalf("expected ErrStatusNotSettable, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateStatus_PropagatesConflict(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	s, _ := NewUpdateStatusService(repo, noAuthorizations{}, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusPaid}, nil)
+	repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusPaid, domain.StatusFulfilled, "").Return(domain.Order{}, domain.ErrStatusConflict)
+
+	if _, err := s.UpdateStatus(context.Background(), "o1", domain.StatusFulfilled, ""); !errors.Is(err, domain.ErrStatusConflict) {
+		t.Fatalf("expected ErrStatusConflict, got %v", err)
+	}
+}
+
+func TestUpdateStatus_ShippingRequestsCaptures(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := orderdb.NewMockOrderRepository(ctrl)
+	authorizations := pmtcmd.NewMockAuthorizationService(ctrl)
+	events := outboxcmd.NewMockPublishService(ctrl)
+	s, _ := NewUpdateStatusService(repo, authorizations, events, stubTx{}, tracer)
+
+	authorized := pmtdomain.Payment{ID: "p1", AuthorizationID: "auth_1", AmountCents: 1000, Status: pmtdomain.StatusAuthorized}
+	repo.EXPECT().GetByID(gomock.Any(), "o1").Return(domain.Order{ID: "o1", Status: domain.StatusFulfilled}, nil)
+	repo.EXPECT().UpdateStatus(gomock.Any(), "o1", domain.StatusFulfilled, domain.StatusShipped, "").Return(domain.Order{ID: "o1", UserID: "u1", Status: domain.StatusShipped}, nil)
+	authorizations.EXPECT().Authorized(gomock.Any(), "o1").Return([]pmtdomain.Payment{authorized}, nil)
+	// the processor is not called here: the capture is sent after commit
+	events.EXPECT().Publish(gomock.Any(), pmtdomain.TopicPaymentCaptureRequested, authorized).Return(nil)
+	events.EXPECT().Publish(gomock.Any(), domain.TopicOrderStatusChanged, gomock.Any()).Return(nil)
+
+	if _, err := s.UpdateStatus(context.Background(), "o1", domain.StatusShipped, ""); err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListShipmentsService interface {
+	ListByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error)
+}
+
+type listShipmentsService struct {
+	repo   repo.ShipmentRepository
+	tracer observability.Tracer
+}
+
+func NewListShipmentsService(r repo.ShipmentRepository, t observability.Tracer) (ListShipmentsService, error) {
+	return &listShipmentsService{repo: r, tracer: t}, nil
+}
+
+func (s *listShipmentsService) ListByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderQuery.ListShipments")
+	defer span.End()
+
+	list, err := s.repo.ListByOrder(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
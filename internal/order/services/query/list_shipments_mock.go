@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/query/list_shipments.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListShipmentsService is a mock of ListShipmentsService interface.
+type MockListShipmentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListShipmentsServiceMockRecorder
+}
+
+// MockListShipmentsServiceMockRecorder is the mock recorder for MockListShipmentsService.
+type MockListShipmentsServiceMockRecorder struct {
+	mock *MockListShipmentsService
+}
+
+// NewMockListShipmentsService creates a new mock instance.
+func NewMockListShipmentsService(ctrl *gomock.Controller) *MockListShipmentsService {
+	mock := &MockListShipmentsService{ctrl: ctrl}
+	mock.recorder = &MockListShipmentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListShipmentsService) EXPECT() *MockListShipmentsServiceMockRecorder {
+	return m.recorder
+}
+
+// ListByOrder mocks base method.
+func (m *MockListShipmentsService) ListByOrder(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrder indicates an expected call of ListByOrder.
+func (mr *MockListShipmentsServiceMockRecorder) ListByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrder", reflect.TypeOf((*MockListShipmentsService)(nil).ListByOrder), ctx, orderID)
+}
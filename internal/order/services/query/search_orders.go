@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/pkg/observability"
+)
+
+type SearchOrdersService interface {
+	Search(ctx context.Context, search repo.OrderSearch) (repo.OrderPage, error)
+}
+
+type searchOrdersService struct {
+	repo   repo.OrderRepository
+	tracer observability.Tracer
+}
+
+func NewSearchOrdersService(r repo.OrderRepository, t observability.Tracer) (SearchOrdersService, error) {
+	return &searchOrdersService{repo: r, tracer: t}, nil
+}
+
+func (s *searchOrdersService) Search(ctx context.Context, search repo.OrderSearch) (repo.OrderPage, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderQuery.Search")
+	defer span.End()
+
+	page, err := s.repo.Search(ctx, search)
+	if err != nil {
+		span.RecordError(err)
+		return repo.OrderPage{}, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/query/search_orders.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	db "r2-challenge/internal/order/adapters/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSearchOrdersService is a mock of SearchOrdersService interface.
+type MockSearchOrdersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchOrdersServiceMockRecorder
+}
+
+// MockSearchOrdersServiceMockRecorder is the mock recorder for MockSearchOrdersService.
+type MockSearchOrdersServiceMockRecorder struct {
+	mock *MockSearchOrdersService
+}
+
+// NewMockSearchOrdersService creates a new mock instance.
+func NewMockSearchOrdersService(ctrl *gomock.Controller) *MockSearchOrdersService {
+	mock := &MockSearchOrdersService{ctrl: ctrl}
+	mock.recorder = &MockSearchOrdersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchOrdersService) EXPECT() *MockSearchOrdersServiceMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchOrdersService) Search(ctx context.Context, search db.OrderSearch) (db.OrderPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, search)
+	ret0, _ := ret[0].(db.OrderPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchOrdersServiceMockRecorder) Search(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchOrdersService)(nil).Search), ctx, search)
+}
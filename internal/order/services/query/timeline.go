@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/order/adapters/db"
+	"r2-challenge/internal/order/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type TimelineService interface {
+	Timeline(ctx context.Context, orderID string) ([]domain.OrderEvent, error)
+}
+
+type timelineService struct {
+	repo   repo.OrderRepository
+	tracer observability.Tracer
+}
+
+func NewTimelineService(r repo.OrderRepository, t observability.Tracer) (TimelineService, error) {
+	return &timelineService{repo: r, tracer: t}, nil
+}
+
+func (s *timelineService) Timeline(ctx context.Context, orderID string) ([]domain.OrderEvent, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OrderQuery.Timeline")
+	defer span.End()
+
+	list, err := s.repo.ListEvents(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/order/services/query/timeline.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/order/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTimelineService is a mock of TimelineService interface.
+type MockTimelineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimelineServiceMockRecorder
+}
+
+// MockTimelineServiceMockRecorder is the mock recorder for MockTimelineService.
+type MockTimelineServiceMockRecorder struct {
+	mock *MockTimelineService
+}
+
+// NewMockTimelineService creates a new mock instance.
+func NewMockTimelineService(ctrl *gomock.Controller) *MockTimelineService {
+	mock := &MockTimelineService{ctrl: ctrl}
+	mock.recorder = &MockTimelineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimelineService) EXPECT() *MockTimelineServiceMockRecorder {
+	return m.recorder
+}
+
+// Timeline mocks base method.
+func (m *MockTimelineService) Timeline(ctx context.Context, orderID string) ([]domain.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", ctx, orderID)
+	ret0, _ := ret[0].([]domain.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockTimelineServiceMockRecorder) Timeline(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockTimelineService)(nil).Timeline), ctx, orderID)
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/outbox/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbOutboxRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (OutboxRepository, error) {
+	return &dbOutboxRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbOutboxRepository) Save(ctx context.Context, events []domain.Event) error {
+	ctx, span := r.tracer.StartSpan(ctx, "OutboxRepository.Save")
+	defer span.End()
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	for i := range events {
+		if events[i].ID == "" {
+			events[i].ID = uuid.NewString()
+		}
+		if events[i].Status == "" {
+			events[i].Status = domain.StatusPending
+		}
+		if events[i].NextAttemptAt.IsZero() {
+			events[i].NextAttemptAt = now
+		}
+		events[i].CreatedAt = now
+		events[i].UpdatedAt = now
+	}
+
+	if err := appdb.Conn(ctx, r.db).Table("outbox").Create(&events).Error; err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *dbOutboxRepository) ClaimNext(ctx context.Context, now time.Time) (domain.Event, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OutboxRepository.ClaimNext")
+	defer span.End()
+
+	var events []domain.Event
+	tx := appdb.Conn(ctx, r.db).Raw(
+		`SELECT * FROM outbox WHERE status = ? AND next_attempt_at <= ?
+		 ORDER BY next_attempt_at, created_at LIMIT 1 FOR UPDATE SKIP LOCKED`,
+		domain.StatusPending, now,
+	).Scan(&events)
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return domain.Event{}, tx.Error
+	}
+	if len(events) == 0 {
+		return domain.Event{}, gorm.ErrRecordNotFound
+	}
+
+	return events[0], nil
+}
+
+func (r *dbOutboxRepository) MarkDispatched(ctx context.Context, id string, at time.Time) error {
+	ctx, span := r.tracer.StartSpan(ctx, "OutboxRepository.MarkDispatched")
+	defer span.End()
+
+	err := appdb.Conn(ctx, r.db).Table("outbox").Where("id = ?", id).Updates(map[string]any{
+		"status":        domain.StatusDispatched,
+		"dispatched_at": at,
+		"updated_at":    at,
+	}).Error
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *dbOutboxRepository) MarkFailed(ctx context.Context, id string, attempts int, lastErr string, nextAttemptAt time.Time, dead bool) error {
+	ctx, span := r.tracer.StartSpan(ctx, "OutboxRepository.MarkFailed")
+	defer span.End()
+
+	status := domain.StatusPending
+	if dead {
+		status = domain.StatusDead
+	}
+
+	err := appdb.Conn(ctx, r.db).Table("outbox").Where("id = ?", id).Updates(map[string]any{
+		"status":          status,
+		"attempts":        attempts,
+		"last_error":      lastErr,
+		"next_attempt_at": nextAttemptAt,
+		"updated_at":      time.Now().UTC(),
+	}).Error
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *dbOutboxRepository) Stats(ctx context.Context) (domain.Stats, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "OutboxRepository.Stats")
+	defer span.End()
+
+	var stats domain.Stats
+	err := appdb.Conn(ctx, r.db).Raw(
+		`SELECT COUNT(*) FILTER (WHERE status = ?) AS pending,
+		        COUNT(*) FILTER (WHERE status = ?) AS dead,
+		        MIN(created_at) FILTER (WHERE status = ?) AS oldest_pending
+		 FROM outbox`,
+		domain.StatusPending, domain.StatusDead, domain.StatusPending,
+	).Scan(&stats).Error
+	if err != nil {
+		span.RecordError(err)
+		return domain.Stats{}, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,21 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"r2-challenge/internal/outbox/domain"
+)
+
+type OutboxRepository interface {
+	Save(ctx context.Context, events []domain.Event) error
+	// ClaimNext locks the oldest pending event due at now, skipping events
+	// locked by other dispatchers. It must run inside a transaction and
+	// returns gorm.ErrRecordNotFound when nothing is due.
+	ClaimNext(ctx context.Context, now time.Time) (domain.Event, error)
+	MarkDispatched(ctx context.Context, id string, at time.Time) error
+	// MarkFailed records a failed attempt and either schedules the next one
+	// at nextAttemptAt or, when dead is set, moves the event to dead.
+	MarkFailed(ctx context.Context, id string, attempts int, lastErr string, nextAttemptAt time.Time, dead bool) error
+	Stats(ctx context.Context) (domain.Stats, error)
+}
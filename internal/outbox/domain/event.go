@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Outbox event delivery statuses.
+const (
+	StatusPending    = "pending"
+	StatusDispatched = "dispatched"
+	// retries exhausted; kept for inspection and manual replay
+	StatusDead = "dead"
+)
+
+// Event is one side effect waiting to be delivered to a single subscriber.
+// Publishing a topic writes one event per subscriber so each one retries and
+// dead-letters independently.
+type Event struct {
+	ID            string          `json:"id" gorm:"primaryKey;type:uuid"`
+	Topic         string          `json:"topic"`
+	Subscriber    string          `json:"subscriber"`
+	Payload       json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	LastError     string          `json:"last_error,omitempty"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	DispatchedAt  *time.Time      `json:"dispatched_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// Stats summarizes the outbox backlog.
+type Stats struct {
+	Pending       int64
+	Dead          int64
+	OldestPending *time.Time
+}
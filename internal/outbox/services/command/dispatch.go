@@ -0,0 +1,159 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
+	outboxdb "r2-challenge/internal/outbox/adapters/db"
+	"r2-challenge/internal/outbox/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+	"r2-challenge/pkg/worker"
+)
+
+const maxRetryBackoff = time.Hour
+
+type DispatchService interface {
+	// DispatchDue delivers due events, up to the configured batch size, and
+	// returns how many were delivered.
+	DispatchDue(ctx context.Context) (int, error)
+}
+
+type dispatchService struct {
+	repo        outboxdb.OutboxRepository
+	registry    *Registry
+	tx          appdb.Transactor
+	tracer      observability.Tracer
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+func NewDispatchService(r outboxdb.OutboxRepository, reg *Registry, tx appdb.Transactor, e envs.Envs, t observability.Tracer) (DispatchService, error) {
+	backoff, err := time.ParseDuration(e.OutboxRetryBackoff)
+	if err != nil || backoff <= 0 {
+		backoff = 2 * time.Second
+	}
+
+	s := &dispatchService{
+		repo:        r,
+		registry:    reg,
+		tx:          tx,
+		tracer:      t,
+		batchSize:   e.OutboxBatchSize,
+		maxAttempts: e.OutboxMaxAttempts,
+		backoff:     backoff,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+	if s.batchSize <= 0 {
+		s.batchSize = 50
+	}
+	if s.maxAttempts <= 0 {
+		s.maxAttempts = 8
+	}
+
+	if err := s.registerMetrics(otel.Meter("r2-challenge")); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *dispatchService) DispatchDue(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "OutboxCommand.DispatchDue")
+	defer span.End()
+
+	now := s.now()
+	delivered := 0
+	for delivered < s.batchSize {
+		var event domain.Event
+		var handlerErr error
+
+		err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+			var err error
+			event, err = s.repo.ClaimNext(ctx, now)
+			if err != nil {
+				return err
+			}
+			if handlerErr = s.deliver(ctx, event); handlerErr != nil {
+				return handlerErr
+			}
+			return s.repo.MarkDispatched(ctx, event.ID, s.now())
+		})
+
+		switch {
+		case err == nil:
+			delivered++
+		case handlerErr != nil:
+			span.RecordError(handlerErr)
+			if ferr := s.fail(ctx, event, handlerErr); ferr != nil {
+				span.RecordError(ferr)
+				return delivered, ferr
+			}
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return delivered, nil
+		default:
+			span.RecordError(err)
+			return delivered, err
+		}
+	}
+
+	return delivered, nil
+}
+
+func (s *dispatchService) deliver(ctx context.Context, event domain.Event) error {
+	h, ok := s.registry.handler(event.Topic, event.Subscriber)
+	if !ok {
+		return fmt.Errorf("no handler registered for %s/%s", event.Topic, event.Subscriber)
+	}
+	return h(ctx, event)
+}
+
+// fail records a failed attempt, scheduling a retry with exponential backoff
+// or dead-lettering the event once attempts are exhausted.
+func (s *dispatchService) fail(ctx context.Context, event domain.Event, cause error) error {
+	attempts := event.Attempts + 1
+	dead := attempts >= s.maxAttempts
+	return s.repo.MarkFailed(ctx, event.ID, attempts, cause.Error(), s.now().Add(worker.Backoff(s.backoff, attempts, maxRetryBackoff)), dead)
+}
+
+// registerMetrics exposes the outbox backlog: pending and dead events and the
+// age of the oldest pending event (outbox lag).
+func (s *dispatchService) registerMetrics(meter metric.Meter) error {
+	lag, err := meter.Float64ObservableGauge("outbox_lag_seconds")
+	if err != nil {
+		return err
+	}
+	pending, err := meter.Int64ObservableGauge("outbox_pending_events")
+	if err != nil {
+		return err
+	}
+	dead, err := meter.Int64ObservableGauge("outbox_dead_events")
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		stats, err := s.repo.Stats(ctx)
+		if err != nil {
+			return err
+		}
+		var age float64
+		if stats.OldestPending != nil {
+			age = s.now().Sub(*stats.OldestPending).Seconds()
+		}
+		o.ObserveFloat64(lag, age)
+		o.ObserveInt64(pending, stats.Pending)
+		o.ObserveInt64(dead, stats.Dead)
+		return nil
+	}, lag, pending, dead)
+	return err
+}
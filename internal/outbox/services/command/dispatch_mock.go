@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/outbox/services/command/dispatch.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDispatchService is a mock of DispatchService interface.
+type MockDispatchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDispatchServiceMockRecorder
+}
+
+// MockDispatchServiceMockRecorder is the mock recorder for MockDispatchService.
+type MockDispatchServiceMockRecorder struct {
+	mock *MockDispatchService
+}
+
+// NewMockDispatchService creates a new mock instance.
+func NewMockDispatchService(ctrl *gomock.Controller) *MockDispatchService {
+	mock := &MockDispatchService{ctrl: ctrl}
+	mock.recorder = &MockDispatchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDispatchService) EXPECT() *MockDispatchServiceMockRecorder {
+	return m.recorder
+}
+
+// DispatchDue mocks base method.
+func (m *MockDispatchService) DispatchDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchDue indicates an expected call of DispatchDue.
+func (mr *MockDispatchServiceMockRecorder) DispatchDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchDue", reflect.TypeOf((*MockDispatchService)(nil).DispatchDue), ctx)
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
+	outboxdb "r2-challenge/internal/outbox/adapters/db"
+	"r2-challenge/internal/outbox/domain"
+	"r2-challenge/pkg/observability"
+	"r2-challenge/pkg/worker"
+)
+
+type stubTx struct{}
+
+func (stubTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newDispatcher(t *testing.T, repo outboxdb.OutboxRepository, reg *Registry) *dispatchService {
+	t.Helper()
+	tracer, _ := observability.SetupTracer()
+	s, err := NewDispatchService(repo, reg, stubTx{}, envs.Envs{OutboxBatchSize: 10, OutboxMaxAttempts: 3, OutboxRetryBackoff: "1s"}, tracer)
+	if err != nil {
+		t.Fatalf("failed to build service: %v", err)
+	}
+	d := s.(*dispatchService)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+	return d
+}
+
+func TestDispatchDue_DeliversAndMarksDispatched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := outboxdb.NewMockOutboxRepository(ctrl)
+	reg := NewRegistry()
+	var got string
+	reg.Subscribe("order.placed", "audit", func(_ context.Context, e domain.Event) error {
+		got = string(e.Payload)
+		return nil
+	})
+	d := newDispatcher(t, repo, reg)
+
+	gomock.InOrder(
+		repo.EXPECT().ClaimNext(gomock.Any(), gomock.Any()).Return(domain.Event{ID: "e1", Topic: "order.placed", Subscriber: "audit", Payload: []byte(`{"id":"o1"}`)}, nil),
+		repo.EXPECT().MarkDispatched(gomock.Any(), "e1", gomock.Any()).Return(nil),
+		repo.EXPECT().ClaimNext(gomock.Any(), gomock.Any()).Return(domain.Event{}, gorm.ErrRecordNotFound),
+	)
+
+	n, err := d.DispatchDue(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("expected 1 delivered, got %d, %v", n, err)
+	}
+	if got != `{"id":"o1"}` {
+		t.Fatalf("handler got %q", got)
+	}
+}
+
+func TestDispatchDue_FailureSchedulesRetryWithBackoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := outboxdb.NewMockOutboxRepository(ctrl)
+	reg := NewRegistry()
+	reg.Subscribe("payment.captured", "email", func(context.Context, domain.Event) error { return errors.New("smtp down") })
+	d := newDispatcher(t, repo, reg)
+	now := d.now()
+
+	gomock.InOrder(
+		repo.EXPECT().ClaimNext(gomock.Any(), gomock.Any()).Return(domain.Event{ID: "e1", Topic: "payment.captured", Subscriber: "email", Attempts: 1}, nil),
+		// second attempt: 1s base doubled once
+		repo.EXPECT().MarkFailed(gomock.Any(), "e1", 2, "smtp down", now.Add(2*time.Second), false).Return(nil),
+		repo.EXPECT().ClaimNext(gomock.Any(), gomock.Any()).Return(domain.Event{}, gorm.ErrRecordNotFound),
+	)
+
+	if n, err := d.DispatchDue(context.Background()); err != nil || n != 0 {
+		t.Fatalf("expected 0 delivered, got %d, %v", n, err)
+	}
+}
+
+func TestDispatchDue_ExhaustedAttemptsDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := outboxdb.NewMockOutboxRepository(ctrl)
+	d := newDispatcher(t, repo, NewRegistry())
+
+	gomock.InOrder(
+		repo.EXPECT().ClaimNext(gomock.Any(), gomock.Any()).Return(domain.Event{ID: "e1", Topic: "gone", Subscriber: "old", Attempts: 2}, nil),
+		repo.EXPECT().MarkFailed(gomock.Any(), "e1", 3, gomock.Any(), gomock.Any(), true).Return(nil),
+		repo.EXPECT().ClaimNext(gomock.Any(), gomock.Any()).Return(domain.Event{}, gorm.ErrRecordNotFound),
+	)
+
+	if _, err := d.DispatchDue(context.Background()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
+func TestRetryDelay_IsCapped(t *testing.T) {
+	if got := worker.Backoff(time.Second, 1, maxRetryBackoff); got != time.Second {
+		t.Fatalf("attempt 1: got %v", got)
+	}
+	if got := worker.Backoff(time.Second, 4, maxRetryBackoff); got != 8*time.Second {
+		t.Fatalf("attempt 4: got %v", got)
+	}
+	if got := worker.Backoff(time.Second, 40, maxRetryBackoff); got != maxRetryBackoff {
+		t.Fatalf("attempt 40: got %v", got)
+	}
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+
+	outboxdb "r2-challenge/internal/outbox/adapters/db"
+	"r2-challenge/internal/outbox/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type PublishService interface {
+	// Publish stores payload for every subscriber of topic. Call it inside the
+	// transaction of the business change so both commit or neither does.
+	Publish(ctx context.Context, topic string, payload any) error
+}
+
+type publishService struct {
+	repo     outboxdb.OutboxRepository
+	registry *Registry
+	tracer   observability.Tracer
+}
+
+func NewPublishService(r outboxdb.OutboxRepository, reg *Registry, t observability.Tracer) (PublishService, error) {
+	return &publishService{repo: r, registry: reg, tracer: t}, nil
+}
+
+func (s *publishService) Publish(ctx context.Context, topic string, payload any) error {
+	ctx, span := s.tracer.StartSpan(ctx, "OutboxCommand.Publish")
+	defer span.End()
+
+	subscribers := s.registry.subscribers(topic)
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	events := make([]domain.Event, 0, len(subscribers))
+	for _, sub := range subscribers {
+		events = append(events, domain.Event{Topic: topic, Subscriber: sub, Payload: body})
+	}
+
+	if err := s.repo.Save(ctx, events); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/outbox/services/command/publish.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPublishService is a mock of PublishService interface.
+type MockPublishService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublishServiceMockRecorder
+}
+
+// MockPublishServiceMockRecorder is the mock recorder for MockPublishService.
+type MockPublishServiceMockRecorder struct {
+	mock *MockPublishService
+}
+
+// NewMockPublishService creates a new mock instance.
+func NewMockPublishService(ctrl *gomock.Controller) *MockPublishService {
+	mock := &MockPublishService{ctrl: ctrl}
+	mock.recorder = &MockPublishServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublishService) EXPECT() *MockPublishServiceMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockPublishService) Publish(ctx context.Context, topic string, payload any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, topic, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPublishServiceMockRecorder) Publish(ctx, topic, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPublishService)(nil).Publish), ctx, topic, payload)
+}
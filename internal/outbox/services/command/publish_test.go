@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	outboxdb "r2-challenge/internal/outbox/adapters/db"
+	"r2-challenge/internal/outbox/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestPublish_OneEventPerSubscriber(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := outboxdb.NewMockOutboxRepository(ctrl)
+	reg := NewRegistry()
+	noop := func(context.Context, domain.Event) error { return nil }
+	reg.Subscribe("payment.captured", "email", noop)
+	reg.Subscribe("payment.captured", "audit", noop)
+
+	s, _ := NewPublishService(repo, reg, tracer)
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.Event) error {
+		if len(events) != 2 || events[0].Subscriber != "audit" || events[1].Subscriber != "email" {
+			t.Fatalf("unexpected events: %+v", events)
+		}
+		if string(events[0].Payload) != `{"id":"p1"}` {
+			t.Fatalf("unexpected payload: %s", events[0].Payload)
+		}
+		return nil
+	})
+
+	if err := s.Publish(context.Background(), "payment.captured", map[string]string{"id": "p1"}); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+}
+
+func TestPublish_NoSubscribersWritesNothing(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	s, _ := NewPublishService(outboxdb.NewMockOutboxRepository(ctrl), NewRegistry(), tracer)
+	if err := s.Publish(context.Background(), "order.placed", struct{}{}); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"r2-challenge/internal/outbox/domain"
+)
+
+// Handler delivers one event. Handlers run inside the dispatcher transaction,
+// so database work done through appdb.Conn commits together with the event
+// being marked dispatched. Delivery is at-least-once; handlers with external
+// side effects must tolerate repeats.
+type Handler func(ctx context.Context, event domain.Event) error
+
+// Registry maps topics to their named subscribers. Subscriptions are made at
+// startup, before events are published.
+type Registry struct {
+	mu       sync.RWMutex
+	handlers map[string]map[string]Handler
+}
+
+func NewRegistry() *Registry {
+	return &Registry{handlers: map[string]map[string]Handler{}}
+}
+
+// Subscribe registers h as subscriber of topic. Registering the same
+// subscriber twice replaces the previous handler.
+func (r *Registry) Subscribe(topic string, subscriber string, h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.handlers[topic] == nil {
+		r.handlers[topic] = map[string]Handler{}
+	}
+	r.handlers[topic][subscriber] = h
+}
+
+func (r *Registry) subscribers(topic string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.handlers[topic]))
+	for name := range r.handlers[topic] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Registry) handler(topic string, subscriber string) (Handler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	h, ok := r.handlers[topic][subscriber]
+	return h, ok
+}
@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
@@ -32,10 +34,367 @@ func (r *dbPaymentRepository) Save(ctx context.Context, payment pmtdomain.Paymen
 	payment.CreatedAt = now
 	payment.UpdatedAt = now
 
-	if err := r.db.WithContext(ctx).Table("payments").Create(&payment).Error; err != nil {
+	if err := appdb.Conn(ctx, r.db).Table("payments").Create(&payment).Error; err != nil {
 		span.RecordError(err)
 		return pmtdomain.Payment{}, err
 	}
 
 	return payment, nil
 }
+
+func (r *dbPaymentRepository) Resolve(ctx context.Context, payment pmtdomain.Payment) (pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.Resolve")
+	defer span.End()
+
+	var payments []pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Raw(
+		"UPDATE payments SET status = ?, receipt_id = ?, authorization_id = ?, updated_at = ? WHERE id = ? AND status = ? RETURNING *",
+		payment.Status, payment.ReceiptID, payment.AuthorizationID, time.Now().UTC(), payment.ID, pmtdomain.StatusPending,
+	).Scan(&payments).Error; err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+	if len(payments) == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return pmtdomain.Payment{}, gorm.ErrRecordNotFound
+	}
+
+	return payments[0], nil
+}
+
+func (r *dbPaymentRepository) GetByID(ctx context.Context, paymentID string) (pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.GetByID")
+	defer span.End()
+
+	var payment pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Table("payments").Where("id = ?", paymentID).First(&payment).Error; err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+func (r *dbPaymentRepository) ListByOrder(ctx context.Context, orderID string) ([]pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.ListByOrder")
+	defer span.End()
+
+	var payments []pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Table("payments").Where("order_id = ?", orderID).Order("created_at").Find(&payments).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *dbPaymentRepository) ListByUser(ctx context.Context, userID string, filter PaymentFilter) ([]pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.ListByUser")
+	defer span.End()
+
+	query := appdb.Conn(ctx, r.db).Table("payments").Order("created_at DESC")
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var payments []pmtdomain.Payment
+	if err := query.Find(&payments).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *dbPaymentRepository) ListRefundsByOrder(ctx context.Context, orderID string) ([]pmtdomain.Refund, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.ListRefundsByOrder")
+	defer span.End()
+
+	var refunds []pmtdomain.Refund
+	if err := appdb.Conn(ctx, r.db).Table("refunds").Where("order_id = ?", orderID).Order("created_at").Find(&refunds).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return refunds, nil
+}
+
+func (r *dbPaymentRepository) RefundOrder(ctx context.Context, orderID string, amountCents int64, reason string) (pmtdomain.RefundResult, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.RefundOrder")
+	defer span.End()
+
+	var result pmtdomain.RefundResult
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var payments []struct {
+			ID            string
+			ReceiptID     string
+			AmountCents   int64
+			RefundedCents int64
+		}
+		if err := tx.Raw(
+			`SELECT p.id, p.receipt_id, p.amount_cents,
+			        COALESCE((SELECT SUM(rf.amount_cents) FROM refunds rf WHERE rf.payment_id = p.id), 0) AS refunded_cents
+			 FROM payments p
+			 WHERE p.order_id = ? AND p.status IN ?
+			 ORDER BY p.created_at
+			 FOR UPDATE`,
+			orderID, []string{pmtdomain.StatusCaptured, pmtdomain.StatusPartiallyRefunded},
+		).Scan(&payments).Error; err != nil {
+			return err
+		}
+
+		var refundable int64
+		for _, p := range payments {
+			refundable += p.AmountCents - p.RefundedCents
+		}
+		if refundable <= 0 {
+			return pmtdomain.ErrNothingToRefund
+		}
+		if amountCents == 0 {
+			amountCents = refundable
+		}
+		if amountCents > refundable {
+			return fmt.Errorf("%w: requested %d, refundable %d", pmtdomain.ErrRefundExceedsCaptured, amountCents, refundable)
+		}
+
+		now := time.Now().UTC()
+		left := amountCents
+		for _, p := range payments {
+			if left == 0 {
+				break
+			}
+			portion := min(left, p.AmountCents-p.RefundedCents)
+			if portion <= 0 {
+				continue
+			}
+			left -= portion
+
+			refund := pmtdomain.Refund{
+				ID:          uuid.NewString(),
+				PaymentID:   p.ID,
+				OrderID:     orderID,
+				ReceiptID:   p.ReceiptID,
+				AmountCents: portion,
+				Reason:      reason,
+				CreatedAt:   now,
+			}
+			if err := tx.Table("refunds").Create(&refund).Error; err != nil {
+				return err
+			}
+
+			status := pmtdomain.StatusPartiallyRefunded
+			if p.RefundedCents+portion == p.AmountCents {
+				status = pmtdomain.StatusRefunded
+			}
+			if err := tx.Table("payments").Where("id = ?", p.ID).Updates(map[string]any{"status": status, "updated_at": now}).Error; err != nil {
+				return err
+			}
+
+			result.Refunds = append(result.Refunds, refund)
+		}
+		result.RemainingCents = refundable - amountCents
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.RefundResult{}, err
+	}
+
+	return result, nil
+}
+
+func (r *dbPaymentRepository) LockAuthorized(ctx context.Context, orderID string) ([]pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.LockAuthorized")
+	defer span.End()
+
+	var payments []pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Raw(
+		"SELECT * FROM payments WHERE order_id = ? AND status = ? ORDER BY created_at FOR UPDATE",
+		orderID, pmtdomain.StatusAuthorized,
+	).Scan(&payments).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *dbPaymentRepository) Transition(ctx context.Context, paymentID string, from string, to string, receiptID string) (pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.Transition")
+	defer span.End()
+
+	var payments []pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Raw(
+		"UPDATE payments SET status = ?, receipt_id = COALESCE(NULLIF(?, ''), receipt_id), updated_at = ? WHERE id = ? AND status = ? RETURNING *",
+		to, receiptID, time.Now().UTC(), paymentID, from,
+	).Scan(&payments).Error; err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+	if len(payments) == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return pmtdomain.Payment{}, gorm.ErrRecordNotFound
+	}
+
+	return payments[0], nil
+}
+
+func (r *dbPaymentRepository) RecordProviderEvent(ctx context.Context, provider string, event pmtdomain.ProviderEvent) (bool, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.RecordProviderEvent")
+	defer span.End()
+
+	res := appdb.Conn(ctx, r.db).Exec(
+		`INSERT INTO payment_provider_events (provider, event_id, type, reference, received_at)
+		 VALUES (?, ?, ?, ?, ?)
+		 ON CONFLICT (provider, event_id) DO NOTHING`,
+		provider, event.ID, event.Type, event.Reference, time.Now().UTC(),
+	)
+	if res.Error != nil {
+		span.RecordError(res.Error)
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+func (r *dbPaymentRepository) TransitionByReference(ctx context.Context, provider string, reference string, from []string, to string) (pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.TransitionByReference")
+	defer span.End()
+
+	if reference == "" {
+		return pmtdomain.Payment{}, gorm.ErrRecordNotFound
+	}
+
+	var payment pmtdomain.Payment
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var found []pmtdomain.Payment
+		if err := tx.Raw(
+			`SELECT * FROM payments
+			 WHERE provider = ? AND (receipt_id = ? OR authorization_id = ?)
+			 ORDER BY created_at DESC
+			 LIMIT 1
+			 FOR UPDATE`,
+			provider, reference, reference,
+		).Scan(&found).Error; err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		current := found[0]
+		if !slices.Contains(from, current.Status) {
+			return fmt.Errorf("%w: payment %s is %s", pmtdomain.ErrInvalidPaymentTransition, current.ID, current.Status)
+		}
+
+		var updated []pmtdomain.Payment
+		if err := tx.Raw(
+			"UPDATE payments SET status = ?, updated_at = ? WHERE id = ? RETURNING *",
+			to, time.Now().UTC(), current.ID,
+		).Scan(&updated).Error; err != nil {
+			return err
+		}
+		payment = updated[0]
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+func (r *dbPaymentRepository) ListByReceipts(ctx context.Context, receiptIDs []string) ([]pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.ListByReceipts")
+	defer span.End()
+
+	if len(receiptIDs) == 0 {
+		return nil, nil
+	}
+
+	var payments []pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Table("payments").Where("receipt_id IN ?", receiptIDs).Find(&payments).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *dbPaymentRepository) ListCollected(ctx context.Context, from time.Time, to time.Time) ([]pmtdomain.Payment, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.ListCollected")
+	defer span.End()
+
+	var payments []pmtdomain.Payment
+	if err := appdb.Conn(ctx, r.db).Table("payments").
+		Where("receipt_id <> '' AND created_at >= ? AND created_at < ?", from, to).
+		Order("created_at").
+		Find(&payments).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *dbPaymentRepository) SaveReconciliation(ctx context.Context, rec pmtdomain.Reconciliation) (pmtdomain.Reconciliation, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.SaveReconciliation")
+	defer span.End()
+
+	if rec.ID == "" {
+		rec.ID = uuid.NewString()
+	}
+	rec.CreatedAt = time.Now().UTC()
+
+	if err := appdb.Conn(ctx, r.db).Table("payment_reconciliations").Create(&rec).Error; err != nil {
+		span.RecordError(err)
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	return rec, nil
+}
+
+func (r *dbPaymentRepository) GetReconciliation(ctx context.Context, id string) (pmtdomain.Reconciliation, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.GetReconciliation")
+	defer span.End()
+
+	var rec pmtdomain.Reconciliation
+	if err := appdb.Conn(ctx, r.db).Table("payment_reconciliations").Where("id = ?", id).First(&rec).Error; err != nil {
+		span.RecordError(err)
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	return rec, nil
+}
+
+func (r *dbPaymentRepository) ListReconciliations(ctx context.Context, limit int, offset int) ([]pmtdomain.Reconciliation, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PaymentRepository.ListReconciliations")
+	defer span.End()
+
+	q := appdb.Conn(ctx, r.db).Table("payment_reconciliations").Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	var list []pmtdomain.Reconciliation
+	if err := q.Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"r2-challenge/cmd/envs"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+// applyMigrations applies all SQL files in db/migrations in lexicographic order.
+func applyMigrations(t *testing.T, gdb *appdb.Database) {
+	t.Helper()
+
+	migrationsDir, err := findMigrationsDir()
+	if err != nil {
+		t.Fatalf("locate migrations dir: %v", err)
+	}
+
+	dirEntries, err := os.ReadDir(migrationsDir)
+	if err != nil {
+		t.Fatalf("read migrations dir: %v", err)
+	}
+
+	files := make([]string, 0, len(dirEntries))
+	for _, e := range dirEntries {
+		if e.IsDir() {
+			continue
+		}
+		if filepath.Ext(e.Name()) != ".sql" {
+			continue
+		}
+		files = append(files, filepath.Join(migrationsDir, e.Name()))
+	}
+	sort.Strings(files)
+
+	for _, f := range files {
+		content, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatalf("read migration %s: %v", f, err)
+		}
+		if err := gdb.Exec(string(content)).Error; err != nil {
+			t.Fatalf("apply migration %s: %v", f, err)
+		}
+	}
+}
+
+// truncateAll removes data to ensure test isolation.
+func truncateAll(t *testing.T, gdb *appdb.Database) {
+	t.Helper()
+	stmts := []string{
+		"TRUNCATE refunds RESTART IDENTITY CASCADE",
+		"TRUNCATE payments RESTART IDENTITY CASCADE",
+		"TRUNCATE orders RESTART IDENTITY CASCADE",
+		"TRUNCATE users RESTART IDENTITY CASCADE",
+	}
+	for _, s := range stmts {
+		if err := gdb.Exec(s).Error; err != nil {
+			t.Fatalf("truncate failed for %s: %v", s, err)
+		}
+	}
+}
+
+func setupDatabase(t *testing.T) (*appdb.Database, observability.Tracer) {
+	t.Helper()
+
+	tracer, err := observability.SetupTracer()
+	if err != nil {
+		t.Fatalf("setup tracer: %v", err)
+	}
+
+	env := envs.Envs{
+		DBHost:            getenvOr("DB_HOST", "localhost"),
+		DBPort:            getenvOr("DB_PORT", "5432"),
+		DBUser:            getenvOr("DB_USER", "postgres"),
+		DBPassword:        getenvOr("DB_PASSWORD", "postgres"),
+		DBName:            getenvOr("DB_NAME", "r2_db"),
+		DBSSLMode:         getenvOr("DB_SSLMODE", "disable"),
+		DBMaxOpenConns:    5,
+		DBMaxIdleConns:    5,
+		DBConnMaxLifetime: "5m",
+	}
+
+	database, err := appdb.Setup(env)
+	if err != nil {
+		t.Skipf("database not available: %v", err)
+	}
+
+	applyMigrations(t, database)
+	truncateAll(t, database)
+
+	return database, tracer
+}
+
+func getenvOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// findMigrationsDir walks up from CWD until it finds db/migrations.
+func findMigrationsDir() (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < 6; i++ {
+		candidate := filepath.Join(cwd, "db", "migrations")
+		if st, err := os.Stat(candidate); err == nil && st.IsDir() {
+			return candidate, nil
+		}
+
+		parent := filepath.Dir(cwd)
+		if parent == cwd {
+			break
+		}
+		cwd = parent
+	}
+
+	return "", errors.New("db/migrations not found walking up directories")
+}
+
+func TestPaymentRepository_RefundOrder_NeverExceedsCaptured(t *testing.T) {
+	database, tracer := setupDatabase(t)
+	payments, err := NewDBRepository(database, tracer)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.NewString()
+	orderID := uuid.NewString()
+	if err := database.Exec(`INSERT INTO users (id, email, password_hash, name, role) VALUES (?, 'r@example.com', 'x', 'Test', 'user')`, userID).Error; err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	if err := database.Exec(`INSERT INTO orders (id, user_id, status, total_cents) VALUES (?, ?, 'paid', 1000)`, orderID, userID).Error; err != nil {
+		t.Fatalf("insert order: %v", err)
+	}
+	if _, err := payments.Save(ctx, pmtdomain.Payment{OrderID: orderID, UserID: userID, AmountCents: 1000, Provider: "mock", ReceiptID: "rcpt_1", Status: pmtdomain.StatusCaptured}); err != nil {
+		t.Fatalf("save payment: %v", err)
+	}
+
+	partial, err := payments.RefundOrder(ctx, orderID, 300, "damaged box")
+	if err != nil {
+		t.Fatalf("partial refund: %v", err)
+	}
+	if len(partial.Refunds) != 1 || partial.Refunds[0].ReceiptID != "rcpt_1" || partial.RemainingCents != 700 {
+		t.Fatalf("unexpected partial refund: %+v", partial)
+	}
+
+	if _, err := payments.RefundOrder(ctx, orderID, 701, ""); !errors.Is(err, pmtdomain.ErrRefundExceedsCaptured) {
+		t.Fatalf("expected ErrRefundExceedsCaptured, got %v", err)
+	}
+
+	rest, err := payments.RefundOrder(ctx, orderID, 0, "")
+	if err != nil {
+		t.Fatalf("full refund: %v", err)
+	}
+	if rest.Refunds[0].AmountCents != 700 || rest.RemainingCents != 0 {
+		t.Fatalf("unexpected remaining refund: %+v", rest)
+	}
+
+	var status string
+	if err := database.Raw(`SELECT status FROM payments WHERE order_id = ?`, orderID).Scan(&status).Error; err != nil {
+		t.Fatalf("read payment status: %v", err)
+	}
+	if status != pmtdomain.StatusRefunded {
+		t.Fatalf("expected refunded payment, got %s", status)
+	}
+
+	if _, err := payments.RefundOrder(ctx, orderID, 0, ""); !errors.Is(err, pmtdomain.ErrNothingToRefund) {
+		t.Fatalf("expected ErrNothingToRefund, got %v", err)
+	}
+}
@@ -2,10 +2,56 @@ package db
 
 import (
 	"context"
+	"time"
 
 	pmtdomain "r2-challenge/internal/payment/domain"
 )
 
+type PaymentFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
 type Repository interface {
 	Save(ctx context.Context, payment pmtdomain.Payment) (pmtdomain.Payment, error)
+	// Resolve records the provider's answer on a pending payment: its status,
+	// receipt and authorization id. gorm.ErrRecordNotFound is returned when
+	// the payment is no longer pending.
+	Resolve(ctx context.Context, payment pmtdomain.Payment) (pmtdomain.Payment, error)
+	GetByID(ctx context.Context, paymentID string) (pmtdomain.Payment, error)
+	// ListByOrder returns the order's payments, oldest first.
+	ListByOrder(ctx context.Context, orderID string) ([]pmtdomain.Payment, error)
+	// ListByUser returns the user's payments, newest first; an empty userID
+	// lists the payments of every user.
+	ListByUser(ctx context.Context, userID string, filter PaymentFilter) ([]pmtdomain.Payment, error)
+	// ListRefundsByOrder returns the order's refunds, oldest first.
+	ListRefundsByOrder(ctx context.Context, orderID string) ([]pmtdomain.Refund, error)
+	// RefundOrder refunds amountCents across the order's refundable payments,
+	// oldest first; zero refunds everything left. Payment rows are locked so
+	// concurrent refunds can never exceed the captured amount.
+	RefundOrder(ctx context.Context, orderID string, amountCents int64, reason string) (pmtdomain.RefundResult, error)
+	// LockAuthorized returns the order's authorized payments, locked until the
+	// surrounding transaction ends.
+	LockAuthorized(ctx context.Context, orderID string) ([]pmtdomain.Payment, error)
+	// Transition moves a single payment from status `from` to `to`, setting its
+	// receipt when receiptID is not empty. gorm.ErrRecordNotFound is returned
+	// when the payment is no longer in `from`.
+	Transition(ctx context.Context, paymentID string, from string, to string, receiptID string) (pmtdomain.Payment, error)
+	// RecordProviderEvent stores an inbound provider event and reports false
+	// when the provider already sent an event with the same id.
+	RecordProviderEvent(ctx context.Context, provider string, event pmtdomain.ProviderEvent) (bool, error)
+	// TransitionByReference moves the provider's payment whose receipt or
+	// authorization id is reference to status `to`. It fails with
+	// gorm.ErrRecordNotFound when there is no such payment and with
+	// pmtdomain.ErrInvalidPaymentTransition when its status is not in `from`.
+	TransitionByReference(ctx context.Context, provider string, reference string, from []string, to string) (pmtdomain.Payment, error)
+	// ListByReceipts returns the payments carrying any of the receipt ids.
+	ListByReceipts(ctx context.Context, receiptIDs []string) ([]pmtdomain.Payment, error)
+	// ListCollected returns payments with a receipt created in [from, to).
+	ListCollected(ctx context.Context, from time.Time, to time.Time) ([]pmtdomain.Payment, error)
+	SaveReconciliation(ctx context.Context, rec pmtdomain.Reconciliation) (pmtdomain.Reconciliation, error)
+	GetReconciliation(ctx context.Context, id string) (pmtdomain.Reconciliation, error)
+	// ListReconciliations returns stored reconciliations, newest first.
+	ListReconciliations(ctx context.Context, limit int, offset int) ([]pmtdomain.Reconciliation, error)
 }
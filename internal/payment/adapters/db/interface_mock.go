@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain "r2-challenge/internal/payment/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,186 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, paymentID string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, paymentID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, paymentID)
+}
+
+// GetReconciliation mocks base method.
+func (m *MockRepository) GetReconciliation(ctx context.Context, id string) (domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconciliation", ctx, id)
+	ret0, _ := ret[0].(domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliation indicates an expected call of GetReconciliation.
+func (mr *MockRepositoryMockRecorder) GetReconciliation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliation", reflect.TypeOf((*MockRepository)(nil).GetReconciliation), ctx, id)
+}
+
+// ListByOrder mocks base method.
+func (m *MockRepository) ListByOrder(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrder indicates an expected call of ListByOrder.
+func (mr *MockRepositoryMockRecorder) ListByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrder", reflect.TypeOf((*MockRepository)(nil).ListByOrder), ctx, orderID)
+}
+
+// ListByReceipts mocks base method.
+func (m *MockRepository) ListByReceipts(ctx context.Context, receiptIDs []string) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByReceipts", ctx, receiptIDs)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByReceipts indicates an expected call of ListByReceipts.
+func (mr *MockRepositoryMockRecorder) ListByReceipts(ctx, receiptIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReceipts", reflect.TypeOf((*MockRepository)(nil).ListByReceipts), ctx, receiptIDs)
+}
+
+// ListByUser mocks base method.
+func (m *MockRepository) ListByUser(ctx context.Context, userID string, filter PaymentFilter) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID, filter)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockRepositoryMockRecorder) ListByUser(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockRepository)(nil).ListByUser), ctx, userID, filter)
+}
+
+// ListCollected mocks base method.
+func (m *MockRepository) ListCollected(ctx context.Context, from, to time.Time) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollected", ctx, from, to)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollected indicates an expected call of ListCollected.
+func (mr *MockRepositoryMockRecorder) ListCollected(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollected", reflect.TypeOf((*MockRepository)(nil).ListCollected), ctx, from, to)
+}
+
+// ListReconciliations mocks base method.
+func (m *MockRepository) ListReconciliations(ctx context.Context, limit, offset int) ([]domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReconciliations", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliations indicates an expected call of ListReconciliations.
+func (mr *MockRepositoryMockRecorder) ListReconciliations(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliations", reflect.TypeOf((*MockRepository)(nil).ListReconciliations), ctx, limit, offset)
+}
+
+// ListRefundsByOrder mocks base method.
+func (m *MockRepository) ListRefundsByOrder(ctx context.Context, orderID string) ([]domain.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRefundsByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRefundsByOrder indicates an expected call of ListRefundsByOrder.
+func (mr *MockRepositoryMockRecorder) ListRefundsByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefundsByOrder", reflect.TypeOf((*MockRepository)(nil).ListRefundsByOrder), ctx, orderID)
+}
+
+// LockAuthorized mocks base method.
+func (m *MockRepository) LockAuthorized(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAuthorized", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAuthorized indicates an expected call of LockAuthorized.
+func (mr *MockRepositoryMockRecorder) LockAuthorized(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuthorized", reflect.TypeOf((*MockRepository)(nil).LockAuthorized), ctx, orderID)
+}
+
+// RecordProviderEvent mocks base method.
+func (m *MockRepository) RecordProviderEvent(ctx context.Context, provider string, event domain.ProviderEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordProviderEvent", ctx, provider, event)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordProviderEvent indicates an expected call of RecordProviderEvent.
+func (mr *MockRepositoryMockRecorder) RecordProviderEvent(ctx, provider, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordProviderEvent", reflect.TypeOf((*MockRepository)(nil).RecordProviderEvent), ctx, provider, event)
+}
+
+// RefundOrder mocks base method.
+func (m *MockRepository) RefundOrder(ctx context.Context, orderID string, amountCents int64, reason string) (domain.RefundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, orderID, amountCents, reason)
+	ret0, _ := ret[0].(domain.RefundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockRepositoryMockRecorder) RefundOrder(ctx, orderID, amountCents, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockRepository)(nil).RefundOrder), ctx, orderID, amountCents, reason)
+}
+
+// Resolve mocks base method.
+func (m *MockRepository) Resolve(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, payment)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockRepositoryMockRecorder) Resolve(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockRepository)(nil).Resolve), ctx, payment)
+}
+
 // Save mocks base method.
 func (m *MockRepository) Save(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
 	m.ctrl.T.Helper()
@@ -49,3 +230,48 @@ func (mr *MockRepositoryMockRecorder) Save(ctx, payment interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRepository)(nil).Save), ctx, payment)
 }
+
+// SaveReconciliation mocks base method.
+func (m *MockRepository) SaveReconciliation(ctx context.Context, rec domain.Reconciliation) (domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReconciliation", ctx, rec)
+	ret0, _ := ret[0].(domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReconciliation indicates an expected call of SaveReconciliation.
+func (mr *MockRepositoryMockRecorder) SaveReconciliation(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReconciliation", reflect.TypeOf((*MockRepository)(nil).SaveReconciliation), ctx, rec)
+}
+
+// Transition mocks base method.
+func (m *MockRepository) Transition(ctx context.Context, paymentID, from, to, receiptID string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, paymentID, from, to, receiptID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockRepositoryMockRecorder) Transition(ctx, paymentID, from, to, receiptID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockRepository)(nil).Transition), ctx, paymentID, from, to, receiptID)
+}
+
+// TransitionByReference mocks base method.
+func (m *MockRepository) TransitionByReference(ctx context.Context, provider, reference string, from []string, to string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionByReference", ctx, provider, reference, from, to)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionByReference indicates an expected call of TransitionByReference.
+func (mr *MockRepositoryMockRecorder) TransitionByReference(ctx, provider, reference, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionByReference", reflect.TypeOf((*MockRepository)(nil).TransitionByReference), ctx, provider, reference, from, to)
+}
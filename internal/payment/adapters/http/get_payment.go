@@ -0,0 +1,58 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/payment/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type GetPaymentHandler struct {
+	service   query.GetPaymentService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetPaymentHandler(s query.GetPaymentService, v *validator.Validate, t observability.Tracer) (GetPaymentHandler, error) {
+	return GetPaymentHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Payment by ID
+// @Summary      Get payment
+// @Description  Get payment by ID (admin only)
+// @Tags         Payments
+// @Produce      json
+// @Param        id   path     string  true  "Payment ID"
+// @Success      200  {object} domain.Payment
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /payments/{id} [get]
+func (h GetPaymentHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PaymentHTTP.GetByID")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	payment, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, payment)
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/payment/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type GetReconciliationHandler struct {
+	service   query.GetReconciliationService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetReconciliationHandler(s query.GetReconciliationService, v *validator.Validate, t observability.Tracer) (GetReconciliationHandler, error) {
+	return GetReconciliationHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Reconciliation
+// @Summary      Get reconciliation
+// @Description  Reconciliation report with its mismatches (admin only)
+// @Tags         Payments
+// @Produce      json
+// @Param        id   path     string  true  "Reconciliation ID"
+// @Success      200  {object} domain.Reconciliation
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /payments/reconciliations/{id} [get]
+func (h GetReconciliationHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PaymentHTTP.GetReconciliation")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	rec, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, rec)
+}
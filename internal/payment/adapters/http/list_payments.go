@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	"r2-challenge/internal/payment/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListPaymentsHandler struct {
+	service   query.ListByUserService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListPaymentsHandler(s query.ListByUserService, v *validator.Validate, t observability.Tracer) (ListPaymentsHandler, error) {
+	return ListPaymentsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Payments
+// @Summary      List payments
+// @Description  List payments of every user, newest first (admin only)
+// @Tags         Payments
+// @Produce      json
+// @Param        user_id  query    string  false  "Only payments of this user"
+// @Param        status   query    string  false  "Payment status"
+// @Param        limit    query    int     false  "Limit"
+// @Param        offset   query    int     false  "Offset"
+// @Success      200      {array}  domain.Payment
+// @Failure      400      {object} map[string]string "Bad Request"
+// @Failure      401      {object} map[string]string "Unauthorized"
+// @Failure      403      {object} map[string]string "Forbidden"
+// @Failure      500      {object} map[string]string "Internal Server Error"
+// @Router       /payments [get]
+func (h ListPaymentsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PaymentHTTP.List")
+	defer span.End()
+
+	userID := c.QueryParam("user_id")
+	if err := h.validator.Var(userID, "omitempty,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid user_id"})
+	}
+
+	filter := pmtdb.PaymentFilter{Status: c.QueryParam("status"), Limit: 50}
+	if s := c.QueryParam("limit"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			filter.Limit = v
+		}
+	}
+	if s := c.QueryParam("offset"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			filter.Offset = v
+		}
+	}
+
+	list, err := h.service.ListByUser(ctx, userID, filter)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
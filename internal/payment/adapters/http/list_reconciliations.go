@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/payment/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListReconciliationsHandler struct {
+	service   query.ListReconciliationsService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListReconciliationsHandler(s query.ListReconciliationsService, v *validator.Validate, t observability.Tracer) (ListReconciliationsHandler, error) {
+	return ListReconciliationsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Reconciliations
+// @Summary      List reconciliations
+// @Description  Stored reconciliation reports, newest first (admin only)
+// @Tags         Payments
+// @Produce      json
+// @Param        limit   query    int  false  "Limit"
+// @Param        offset  query    int  false  "Offset"
+// @Success      200     {array}  domain.Reconciliation
+// @Failure      401     {object} map[string]string "Unauthorized"
+// @Failure      403     {object} map[string]string "Forbidden"
+// @Failure      500     {object} map[string]string "Internal Server Error"
+// @Router       /payments/reconciliations [get]
+func (h ListReconciliationsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PaymentHTTP.ListReconciliations")
+	defer span.End()
+
+	limit := 20
+	if s := c.QueryParam("limit"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			limit = v
+		}
+	}
+	offset := 0
+	if s := c.QueryParam("offset"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			offset = v
+		}
+	}
+
+	list, err := h.service.List(ctx, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
+	ordercmd "r2-challenge/internal/order/services/command"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+// maxProviderEventBytes bounds the body read from a provider before its signature is checked.
+const maxProviderEventBytes = 1 << 20
+
+type ProviderWebhookHandler struct {
+	service   ordercmd.ApplyPaymentEventService
+	secrets   map[string]string
+	tolerance time.Duration
+	now       func() time.Time
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewProviderWebhookHandler(s ordercmd.ApplyPaymentEventService, e envs.Envs, v *validator.Validate, t observability.Tracer) (ProviderWebhookHandler, error) {
+	secrets, err := parseProviderSecrets(e.PaymentWebhookSecrets)
+	if err != nil {
+		return ProviderWebhookHandler{}, err
+	}
+	tolerance, err := time.ParseDuration(e.PaymentWebhookTolerance)
+	if err != nil || tolerance <= 0 {
+		tolerance = 5 * time.Minute
+	}
+	return ProviderWebhookHandler{service: s, secrets: secrets, tolerance: tolerance, now: time.Now, validator: v, tracer: t}, nil
+}
+
+// parseProviderSecrets reads "<provider>=<secret>" pairs separated by commas.
+func parseProviderSecrets(raw string) (map[string]string, error) {
+	secrets := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		provider, secret, ok := strings.Cut(pair, "=")
+		if !ok || provider == "" || secret == "" {
+			return nil, fmt.Errorf("invalid PAYMENT_WEBHOOK_SECRETS entry %q", pair)
+		}
+		secrets[provider] = secret
+	}
+	return secrets, nil
+}
+
+// Receive Payment Provider Webhook
+// @Summary      Receive payment provider event
+// @Description  Signed asynchronous payment outcome (failure, chargeback) from a payment provider; public, authenticated by the X-Payment-Signature header
+// @Tags         Payments
+// @Accept       json
+// @Param        provider  path  string                true  "Provider name"
+// @Param        body      body  domain.ProviderEvent  true  "Provider event"
+// @Success      204
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /payments/webhooks/{provider} [post]
+func (h ProviderWebhookHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PaymentHTTP.ProviderWebhook")
+	defer span.End()
+
+	provider := c.Param("provider")
+	secret, ok := h.secrets[provider]
+	if !ok {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "unknown provider"})
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxProviderEventBytes))
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+
+	if err := pmtdomain.VerifySignature(secret, c.Request().Header.Get(pmtdomain.HeaderProviderSignature), body, h.now(), h.tolerance); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+	}
+
+	var event pmtdomain.ProviderEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(event); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	if err := h.service.Apply(ctx, provider, event); err != nil {
+		span.RecordError(err)
+		// the provider retries; the payment may not be recorded yet
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "payment not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
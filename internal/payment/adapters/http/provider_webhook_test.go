@@ -0,0 +1,108 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+	vsetup "r2-challenge/pkg/validator"
+)
+
+type fakeApplyService struct {
+	applied []pmtdomain.ProviderEvent
+	err     error
+}
+
+func (f *fakeApplyService) Apply(_ context.Context, _ string, e pmtdomain.ProviderEvent) error {
+	f.applied = append(f.applied, e)
+	return f.err
+}
+
+func sign(secret string, ts time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", ts.Unix())
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", ts.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
+
+func serveProviderEvent(t *testing.T, svc *fakeApplyService, provider string, body []byte, signature string) *httptest.ResponseRecorder {
+	t.Helper()
+	v, _ := vsetup.Setup()
+	tracer, _ := observability.SetupTracer()
+	h, err := NewProviderWebhookHandler(svc, envs.Envs{PaymentWebhookSecrets: "gateway=whsec_test", PaymentWebhookTolerance: "5m"}, v, tracer)
+	require.NoError(t, err)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/v1/payments/webhooks/"+provider, bytes.NewReader(body))
+	req.Header.Set(pmtdomain.HeaderProviderSignature, signature)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues(provider)
+
+	require.NoError(t, h.Handle(c))
+	return rec
+}
+
+func TestProviderWebhook_AppliesSignedEvent(t *testing.T) {
+	svc := &fakeApplyService{}
+	body := []byte(`{"id":"evt_1","type":"payment.charged_back","reference":"rcpt_1"}`)
+
+	rec := serveProviderEvent(t, svc, "gateway", body, sign("whsec_test", time.Now(), body))
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Len(t, svc.applied, 1)
+	require.Equal(t, "evt_1", svc.applied[0].ID)
+}
+
+func TestProviderWebhook_RejectsBadSignature(t *testing.T) {
+	svc := &fakeApplyService{}
+	body := []byte(`{"id":"evt_1","type":"payment.failed","reference":"rcpt_1"}`)
+
+	rec := serveProviderEvent(t, svc, "gateway", body, sign("wrong", time.Now(), body))
+
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Empty(t, svc.applied)
+}
+
+func TestProviderWebhook_RejectsStaleTimestamp(t *testing.T) {
+	svc := &fakeApplyService{}
+	body := []byte(`{"id":"evt_1","type":"payment.failed","reference":"rcpt_1"}`)
+
+	rec := serveProviderEvent(t, svc, "gateway", body, sign("whsec_test", time.Now().Add(-time.Hour), body))
+
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Empty(t, svc.applied)
+}
+
+func TestProviderWebhook_UnknownProvider(t *testing.T) {
+	svc := &fakeApplyService{}
+	body := []byte(`{"id":"evt_1","type":"payment.failed","reference":"rcpt_1"}`)
+
+	rec := serveProviderEvent(t, svc, "acme", body, sign("whsec_test", time.Now(), body))
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestProviderWebhook_UnknownPaymentAsksForRetry(t *testing.T) {
+	svc := &fakeApplyService{err: gorm.ErrRecordNotFound}
+	body := []byte(`{"id":"evt_1","type":"payment.failed","reference":"rcpt_x"}`)
+
+	rec := serveProviderEvent(t, svc, "gateway", body, sign("whsec_test", time.Now(), body))
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/payment/adapters/settlement"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/internal/payment/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+// maxSettlementReportBytes bounds an uploaded settlement report.
+const maxSettlementReportBytes = 32 << 20
+
+type ReconcileHandler struct {
+	service   command.ReconcileService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewReconcileHandler(s command.ReconcileService, v *validator.Validate, t observability.Tracer) (ReconcileHandler, error) {
+	return ReconcileHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Reconcile Payments
+// @Summary      Reconcile payments
+// @Description  Match a settlement report against payments created in [from, to) and store the mismatches (admin only). Send the report as a text/csv body, or no body to pull it from the payment processor
+// @Tags         Payments
+// @Accept       text/csv
+// @Produce      json
+// @Param        from  query    string  false  "Period start, RFC3339 (default: 24h before to)"
+// @Param        to    query    string  false  "Period end, RFC3339 (default: now)"
+// @Success      201   {object} domain.Reconciliation
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      422   {object} map[string]string "Processor has no settlement report"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /payments/reconciliations [post]
+func (h ReconcileHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PaymentHTTP.Reconcile")
+	defer span.End()
+
+	to := time.Now().UTC()
+	if s := c.QueryParam("to"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			span.RecordError(err)
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid to"})
+		}
+		to = t
+	}
+	from := to.Add(-24 * time.Hour)
+	if s := c.QueryParam("from"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			span.RecordError(err)
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid from"})
+		}
+		from = t
+	}
+	if !from.Before(to) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "from must be before to"})
+	}
+
+	var (
+		rec pmtdomain.Reconciliation
+		err error
+	)
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), "text/csv") {
+		settlements, perr := settlement.ParseCSV(io.LimitReader(c.Request().Body, maxSettlementReportBytes))
+		if perr != nil {
+			span.RecordError(perr)
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": perr.Error()})
+		}
+		rec, err = h.service.ReconcileReport(ctx, from, to, settlements)
+	} else {
+		rec, err = h.service.Reconcile(ctx, from, to)
+	}
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, pmtdomain.ErrNoSettlementReport) {
+			return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, rec)
+}
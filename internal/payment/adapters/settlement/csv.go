@@ -0,0 +1,67 @@
+// Package settlement reads provider settlement reports.
+package settlement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pmtdomain "r2-challenge/internal/payment/domain"
+)
+
+// ParseCSV reads a settlement report with a header row naming at least the
+// receipt_id, amount_cents and status columns, in any order. Extra columns
+// are ignored.
+func ParseCSV(r io.Reader) ([]pmtdomain.Settlement, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("settlement report is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"receipt_id", "amount_cents", "status"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("settlement report is missing the %s column", required)
+		}
+	}
+
+	var settlements []pmtdomain.Settlement
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := strconv.ParseInt(strings.TrimSpace(record[columns["amount_cents"]]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("settlement report line %d: invalid amount_cents: %w", line, err)
+		}
+		receiptID := strings.TrimSpace(record[columns["receipt_id"]])
+		if receiptID == "" {
+			return nil, fmt.Errorf("settlement report line %d: empty receipt_id", line)
+		}
+
+		settlements = append(settlements, pmtdomain.Settlement{
+			ReceiptID:   receiptID,
+			AmountCents: amount,
+			Status:      strings.TrimSpace(record[columns["status"]]),
+		})
+	}
+
+	return settlements, nil
+}
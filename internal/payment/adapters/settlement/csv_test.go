@@ -0,0 +1,38 @@
+package settlement
+
+import (
+	"strings"
+	"testing"
+
+	pmtdomain "r2-challenge/internal/payment/domain"
+)
+
+func TestParseCSV_ReadsColumnsByName(t *testing.T) {
+	report := "status,receipt_id,fee_cents,amount_cents\ncaptured,rcpt_1,30,1000\nrefunded, rcpt_2 ,0,250\n"
+
+	got, err := ParseCSV(strings.NewReader(report))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	want := []pmtdomain.Settlement{
+		{ReceiptID: "rcpt_1", AmountCents: 1000, Status: "captured"},
+		{ReceiptID: "rcpt_2", AmountCents: 250, Status: "refunded"},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("unexpected settlements: %+v", got)
+	}
+}
+
+func TestParseCSV_RejectsMalformedReports(t *testing.T) {
+	for name, report := range map[string]string{
+		"empty":          "",
+		"missing column": "receipt_id,amount_cents\nrcpt_1,1000\n",
+		"bad amount":     "receipt_id,amount_cents,status\nrcpt_1,ten,captured\n",
+		"empty receipt":  "receipt_id,amount_cents,status\n,1000,captured\n",
+	} {
+		if _, err := ParseCSV(strings.NewReader(report)); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package domain
+
+// Outbox topics published by the payment flow.
+const (
+	// TopicPaymentAuthorized carries the authorized Payment.
+	TopicPaymentAuthorized = "payment.authorized"
+	// TopicPaymentCaptureRequested carries an authorized Payment to capture
+	// once the shipment that collects it has committed.
+	TopicPaymentCaptureRequested = "payment.capture_requested"
+	// TopicPaymentCaptured carries the captured Payment.
+	TopicPaymentCaptured = "payment.captured"
+	// TopicPaymentRefunded carries a Refund.
+	TopicPaymentRefunded = "payment.refunded"
+	// TopicPaymentVoided carries the voided Payment.
+	TopicPaymentVoided = "payment.voided"
+	// TopicPaymentFailed carries a Payment the provider reported as failed.
+	TopicPaymentFailed = "payment.failed"
+	// TopicPaymentChargedBack carries a Payment the provider reported as charged back.
+	TopicPaymentChargedBack = "payment.charged_back"
+)
@@ -2,14 +2,41 @@ package domain
 
 import "time"
 
+// Payment lifecycle statuses. A payment is pending from checkout until the
+// provider's answer is recorded, so a charge whose result was lost is never
+// mistaken for an unpaid order. Immediate charges then become captured;
+// two-phase payments go authorized → captured, authorized → failed when the
+// capture is declined, or authorized → voided when the order is cancelled
+// before it ships. A declined payment is failed, and
+// providers may later report a payment as failed or charged_back.
+const (
+	StatusPending           = "pending"
+	StatusAuthorized        = "authorized"
+	StatusCaptured          = "captured"
+	StatusPartiallyRefunded = "partially_refunded"
+	StatusRefunded          = "refunded"
+	StatusVoided            = "voided"
+	StatusChargedBack       = "charged_back"
+	StatusFailed            = "failed"
+)
+
+// Capture modes selected with PAYMENT_CAPTURE_MODE.
+const (
+	// CaptureImmediate charges the order total at checkout.
+	CaptureImmediate = "immediate"
+	// CaptureOnShipment authorizes at checkout and captures when the order ships.
+	CaptureOnShipment = "on_shipment"
+)
+
 type Payment struct {
-	ID          string    `json:"id"`
-	OrderID     string    `json:"order_id"`
-	UserID      string    `json:"user_id"`
-	AmountCents int64     `json:"amount_cents"`
-	Provider    string    `json:"provider"`
-	ReceiptID   string    `json:"receipt_id"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID              string    `json:"id"`
+	OrderID         string    `json:"order_id"`
+	UserID          string    `json:"user_id"`
+	AmountCents     int64     `json:"amount_cents"`
+	Provider        string    `json:"provider"`
+	ReceiptID       string    `json:"receipt_id"`
+	AuthorizationID string    `json:"authorization_id,omitempty"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
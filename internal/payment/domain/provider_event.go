@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HeaderProviderSignature carries the signature of an inbound provider event,
+// in the form "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">".
+const HeaderProviderSignature = "X-Payment-Signature"
+
+// Provider event types.
+const (
+	// ProviderEventFailed reports that a charge or authorization failed after
+	// the provider first accepted it.
+	ProviderEventFailed = "payment.failed"
+	// ProviderEventChargedBack reports that the customer disputed a captured
+	// payment and the funds were returned to them.
+	ProviderEventChargedBack = "payment.charged_back"
+)
+
+var (
+	// ErrInvalidSignature is returned when a provider event signature is missing or wrong.
+	ErrInvalidSignature = errors.New("invalid provider signature")
+	// ErrStaleSignature is returned when a provider event was signed outside the accepted window.
+	ErrStaleSignature = errors.New("provider signature timestamp outside tolerance")
+	// ErrUnsupportedEvent is returned for provider event types that change nothing.
+	ErrUnsupportedEvent = errors.New("unsupported provider event")
+	// ErrInvalidPaymentTransition is returned when a provider event does not
+	// apply to the payment's current status.
+	ErrInvalidPaymentTransition = errors.New("provider event does not apply to payment status")
+)
+
+// ProviderEvent is an asynchronous payment outcome sent by a provider.
+type ProviderEvent struct {
+	ID   string `json:"id" validate:"required"`
+	Type string `json:"type" validate:"required"`
+	// Reference is the receipt or authorization id of the payment.
+	Reference string `json:"reference" validate:"required"`
+	Reason    string `json:"reason"`
+}
+
+// Transition returns the payment statuses the event applies to and the status
+// it moves the payment to.
+func (e ProviderEvent) Transition() (from []string, to string, err error) {
+	switch e.Type {
+	case ProviderEventFailed:
+		return []string{StatusAuthorized, StatusCaptured}, StatusFailed, nil
+	case ProviderEventChargedBack:
+		return []string{StatusCaptured, StatusPartiallyRefunded}, StatusChargedBack, nil
+	default:
+		return nil, "", ErrUnsupportedEvent
+	}
+}
+
+// VerifySignature checks header against body signed with secret and rejects
+// signatures whose timestamp is more than tolerance away from now.
+func VerifySignature(secret string, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			ts = v
+		case "v1":
+			sig = v
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || sig == "" {
+		return ErrInvalidSignature
+	}
+
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return ErrStaleSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	want := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(want), []byte(sig)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrNoSettlementReport is returned when the payment provider cannot report
+// what it settled.
+var ErrNoSettlementReport = errors.New("payment provider has no settlement report")
+
+// Reconciliation sources.
+const (
+	SourceProcessor = "processor"
+	SourceCSV       = "csv"
+)
+
+// Mismatch kinds.
+const (
+	// MismatchMissingPayment: the provider settled a receipt we have no payment for.
+	MismatchMissingPayment = "missing_payment"
+	// MismatchMissingSettlement: a collected payment the provider did not report.
+	MismatchMissingSettlement = "missing_settlement"
+	MismatchAmount            = "amount_differs"
+	MismatchStatus            = "status_differs"
+)
+
+// Settlement is one row of a provider settlement report. Status uses the
+// payment status vocabulary (captured, partially_refunded, refunded, ...).
+type Settlement struct {
+	ReceiptID   string `json:"receipt_id"`
+	AmountCents int64  `json:"amount_cents"`
+	Status      string `json:"status"`
+}
+
+// Mismatch is a difference between the payments table and a settlement report.
+type Mismatch struct {
+	Kind          string `json:"kind"`
+	ReceiptID     string `json:"receipt_id"`
+	PaymentID     string `json:"payment_id,omitempty"`
+	OrderID       string `json:"order_id,omitempty"`
+	ExpectedCents int64  `json:"expected_cents"`
+	SettledCents  int64  `json:"settled_cents"`
+	Status        string `json:"status,omitempty"`
+	SettledStatus string `json:"settled_status,omitempty"`
+}
+
+// Reconciliation is the stored result of matching a settlement report against payments.
+type Reconciliation struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:uuid"`
+	Source     string     `json:"source"`
+	PeriodFrom time.Time  `json:"period_from"`
+	PeriodTo   time.Time  `json:"period_to"`
+	Checked    int        `json:"checked"`
+	Mismatches []Mismatch `json:"mismatches" gorm:"serializer:json"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// settledStatuses are the payment statuses expected to appear in a settlement report.
+var settledStatuses = map[string]bool{
+	StatusCaptured:          true,
+	StatusPartiallyRefunded: true,
+	StatusRefunded:          true,
+	StatusChargedBack:       true,
+}
+
+// Reconcile matches settlements to payments by receipt id. Payments that
+// were never collected (authorized, voided, failed) are only compared when
+// the report mentions them.
+func Reconcile(payments []Payment, settlements []Settlement) []Mismatch {
+	byReceipt := make(map[string]Payment, len(payments))
+	for _, p := range payments {
+		if p.ReceiptID != "" {
+			byReceipt[p.ReceiptID] = p
+		}
+	}
+
+	mismatches := []Mismatch{}
+	seen := make(map[string]bool, len(settlements))
+	for _, s := range settlements {
+		seen[s.ReceiptID] = true
+		p, ok := byReceipt[s.ReceiptID]
+		if !ok {
+			mismatches = append(mismatches, Mismatch{Kind: MismatchMissingPayment, ReceiptID: s.ReceiptID, SettledCents: s.AmountCents, SettledStatus: s.Status})
+			continue
+		}
+		m := Mismatch{
+			ReceiptID: s.ReceiptID, PaymentID: p.ID, OrderID: p.OrderID,
+			ExpectedCents: p.AmountCents, SettledCents: s.AmountCents,
+			Status: p.Status, SettledStatus: s.Status,
+		}
+		if p.AmountCents != s.AmountCents {
+			m.Kind = MismatchAmount
+			mismatches = append(mismatches, m)
+		}
+		if p.Status != s.Status {
+			m.Kind = MismatchStatus
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	for receiptID, p := range byReceipt {
+		if seen[receiptID] || !settledStatuses[p.Status] {
+			continue
+		}
+		mismatches = append(mismatches, Mismatch{Kind: MismatchMissingSettlement, ReceiptID: receiptID, PaymentID: p.ID, OrderID: p.OrderID, ExpectedCents: p.AmountCents, Status: p.Status})
+	}
+
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		if mismatches[i].ReceiptID != mismatches[j].ReceiptID {
+			return mismatches[i].ReceiptID < mismatches[j].ReceiptID
+		}
+		return mismatches[i].Kind < mismatches[j].Kind
+	})
+	return mismatches
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNothingToRefund is returned when the order has no captured amount left to refund.
+var ErrNothingToRefund = errors.New("nothing left to refund")
+
+// ErrRefundExceedsCaptured is returned when a refund would take total refunds
+// above the captured amount.
+var ErrRefundExceedsCaptured = errors.New("refund exceeds captured amount")
+
+// Refund is money returned against a captured payment. ReceiptID is the
+// receipt of the refunded payment, as known to the processor.
+type Refund struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid"`
+	PaymentID   string    `json:"payment_id"`
+	OrderID     string    `json:"order_id"`
+	ReceiptID   string    `json:"receipt_id"`
+	AmountCents int64     `json:"amount_cents"`
+	Reason      string    `json:"reason,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// RefundResult lists the refunds issued by one request and what can still
+// be refunded on the order afterwards.
+type RefundResult struct {
+	Refunds        []Refund `json:"refunds"`
+	RemainingCents int64    `json:"remaining_cents"`
+}
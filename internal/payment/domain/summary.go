@@ -0,0 +1,33 @@
+package domain
+
+// Summary aggregates the payments and refunds of one order.
+type Summary struct {
+	// Status of the most recent payment; empty when the order has none.
+	Status          string `json:"status"`
+	AuthorizedCents int64  `json:"authorized_cents"`
+	CapturedCents   int64  `json:"captured_cents"`
+	RefundedCents   int64  `json:"refunded_cents"`
+	// NetCents is what the order has collected after refunds.
+	NetCents int64 `json:"net_cents"`
+	Payments int   `json:"payments"`
+}
+
+// Summarize builds the Summary of an order's payments, oldest first, and their refunds.
+func Summarize(payments []Payment, refunds []Refund) Summary {
+	var s Summary
+	for _, p := range payments {
+		switch p.Status {
+		case StatusAuthorized:
+			s.AuthorizedCents += p.AmountCents
+		case StatusCaptured, StatusPartiallyRefunded, StatusRefunded:
+			s.CapturedCents += p.AmountCents
+		}
+		s.Status = p.Status
+	}
+	for _, r := range refunds {
+		s.RefundedCents += r.AmountCents
+	}
+	s.NetCents = s.CapturedCents - s.RefundedCents
+	s.Payments = len(payments)
+	return s
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type AuthorizationService interface {
+	// Authorized returns the order's authorized payments, locked until the
+	// surrounding transaction ends so they are captured or voided only once.
+	Authorized(ctx context.Context, orderID string) ([]pmtdomain.Payment, error)
+	// MarkCaptured records the receipt of a captured authorization.
+	MarkCaptured(ctx context.Context, paymentID string, receiptID string) (pmtdomain.Payment, error)
+	// MarkVoided records that an authorization was released.
+	MarkVoided(ctx context.Context, paymentID string) (pmtdomain.Payment, error)
+	// MarkFailed records that the provider declined to capture an authorization.
+	MarkFailed(ctx context.Context, paymentID string) (pmtdomain.Payment, error)
+}
+
+type authorizationService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewAuthorizationService(r pmtdb.Repository, t observability.Tracer) (AuthorizationService, error) {
+	return &authorizationService{repo: r, tracer: t}, nil
+}
+
+func (s *authorizationService) Authorized(ctx context.Context, orderID string) ([]pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.Authorized")
+	defer span.End()
+
+	payments, err := s.repo.LockAuthorized(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (s *authorizationService) MarkCaptured(ctx context.Context, paymentID string, receiptID string) (pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.MarkCaptured")
+	defer span.End()
+
+	captured, err := s.repo.Transition(ctx, paymentID, pmtdomain.StatusAuthorized, pmtdomain.StatusCaptured, receiptID)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return captured, nil
+}
+
+func (s *authorizationService) MarkVoided(ctx context.Context, paymentID string) (pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.MarkVoided")
+	defer span.End()
+
+	voided, err := s.repo.Transition(ctx, paymentID, pmtdomain.StatusAuthorized, pmtdomain.StatusVoided, "")
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return voided, nil
+}
+
+func (s *authorizationService) MarkFailed(ctx context.Context, paymentID string) (pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.MarkFailed")
+	defer span.End()
+
+	failed, err := s.repo.Transition(ctx, paymentID, pmtdomain.StatusAuthorized, pmtdomain.StatusFailed, "")
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return failed, nil
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/command/authorize_payment.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthorizationService is a mock of AuthorizationService interface.
+type MockAuthorizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationServiceMockRecorder
+}
+
+// MockAuthorizationServiceMockRecorder is the mock recorder for MockAuthorizationService.
+type MockAuthorizationServiceMockRecorder struct {
+	mock *MockAuthorizationService
+}
+
+// NewMockAuthorizationService creates a new mock instance.
+func NewMockAuthorizationService(ctrl *gomock.Controller) *MockAuthorizationService {
+	mock := &MockAuthorizationService{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationService) EXPECT() *MockAuthorizationServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorized mocks base method.
+func (m *MockAuthorizationService) Authorized(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorized", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorized indicates an expected call of Authorized.
+func (mr *MockAuthorizationServiceMockRecorder) Authorized(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorized", reflect.TypeOf((*MockAuthorizationService)(nil).Authorized), ctx, orderID)
+}
+
+// MarkCaptured mocks base method.
+func (m *MockAuthorizationService) MarkCaptured(ctx context.Context, paymentID, receiptID string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCaptured", ctx, paymentID, receiptID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkCaptured indicates an expected call of MarkCaptured.
+func (mr *MockAuthorizationServiceMockRecorder) MarkCaptured(ctx, paymentID, receiptID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCaptured", reflect.TypeOf((*MockAuthorizationService)(nil).MarkCaptured), ctx, paymentID, receiptID)
+}
+
+// MarkFailed mocks base method.
+func (m *MockAuthorizationService) MarkFailed(ctx context.Context, paymentID string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, paymentID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockAuthorizationServiceMockRecorder) MarkFailed(ctx, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockAuthorizationService)(nil).MarkFailed), ctx, paymentID)
+}
+
+// MarkVoided mocks base method.
+func (m *MockAuthorizationService) MarkVoided(ctx context.Context, paymentID string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVoided", ctx, paymentID)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkVoided indicates an expected call of MarkVoided.
+func (mr *MockAuthorizationServiceMockRecorder) MarkVoided(ctx, paymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVoided", reflect.TypeOf((*MockAuthorizationService)(nil).MarkVoided), ctx, paymentID)
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ProviderEventService interface {
+	// Record stores the event and reports false when it was already received.
+	Record(ctx context.Context, provider string, event pmtdomain.ProviderEvent) (bool, error)
+	// Apply moves the payment the event references to the status the event
+	// reports. Event types that change nothing fail with
+	// pmtdomain.ErrUnsupportedEvent.
+	Apply(ctx context.Context, provider string, event pmtdomain.ProviderEvent) (pmtdomain.Payment, error)
+}
+
+type providerEventService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewProviderEventService(r pmtdb.Repository, t observability.Tracer) (ProviderEventService, error) {
+	return &providerEventService{repo: r, tracer: t}, nil
+}
+
+func (s *providerEventService) Record(ctx context.Context, provider string, event pmtdomain.ProviderEvent) (bool, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.RecordProviderEvent")
+	defer span.End()
+
+	fresh, err := s.repo.RecordProviderEvent(ctx, provider, event)
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+
+	return fresh, nil
+}
+
+func (s *providerEventService) Apply(ctx context.Context, provider string, event pmtdomain.ProviderEvent) (pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.ApplyProviderEvent")
+	defer span.End()
+
+	from, to, err := event.Transition()
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	payment, err := s.repo.TransitionByReference(ctx, provider, event.Reference, from, to)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return payment, nil
+}
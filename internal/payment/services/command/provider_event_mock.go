@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/command/provider_event.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockProviderEventService is a mock of ProviderEventService interface.
+type MockProviderEventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderEventServiceMockRecorder
+}
+
+// MockProviderEventServiceMockRecorder is the mock recorder for MockProviderEventService.
+type MockProviderEventServiceMockRecorder struct {
+	mock *MockProviderEventService
+}
+
+// NewMockProviderEventService creates a new mock instance.
+func NewMockProviderEventService(ctrl *gomock.Controller) *MockProviderEventService {
+	mock := &MockProviderEventService{ctrl: ctrl}
+	mock.recorder = &MockProviderEventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderEventService) EXPECT() *MockProviderEventServiceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockProviderEventService) Apply(ctx context.Context, provider string, event domain.ProviderEvent) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, provider, event)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockProviderEventServiceMockRecorder) Apply(ctx, provider, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockProviderEventService)(nil).Apply), ctx, provider, event)
+}
+
+// Record mocks base method.
+func (m *MockProviderEventService) Record(ctx context.Context, provider string, event domain.ProviderEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, provider, event)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockProviderEventServiceMockRecorder) Record(ctx, provider, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockProviderEventService)(nil).Record), ctx, provider, event)
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"r2-challenge/internal/order/adapters/payment"
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ReconcileService interface {
+	// Reconcile pulls the processor's settlement report for payments created
+	// in [from, to), matches it against the payments table and stores the
+	// result. It fails with pmtdomain.ErrNoSettlementReport when the
+	// processor cannot report settlements.
+	Reconcile(ctx context.Context, from time.Time, to time.Time) (pmtdomain.Reconciliation, error)
+	// ReconcileReport does the same with a report supplied by the caller,
+	// such as an uploaded CSV file.
+	ReconcileReport(ctx context.Context, from time.Time, to time.Time, settlements []pmtdomain.Settlement) (pmtdomain.Reconciliation, error)
+}
+
+type reconcileService struct {
+	repo      pmtdb.Repository
+	processor payment.Processor
+	tracer    observability.Tracer
+}
+
+func NewReconcileService(r pmtdb.Repository, p payment.Processor, t observability.Tracer) (ReconcileService, error) {
+	return &reconcileService{repo: r, processor: p, tracer: t}, nil
+}
+
+func (s *reconcileService) Reconcile(ctx context.Context, from time.Time, to time.Time) (pmtdomain.Reconciliation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.Reconcile")
+	defer span.End()
+
+	settlements, err := s.processor.Settlements(ctx, from, to)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	rec, err := s.reconcile(ctx, pmtdomain.SourceProcessor, from, to, settlements)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	return rec, nil
+}
+
+func (s *reconcileService) ReconcileReport(ctx context.Context, from time.Time, to time.Time, settlements []pmtdomain.Settlement) (pmtdomain.Reconciliation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.ReconcileReport")
+	defer span.End()
+
+	rec, err := s.reconcile(ctx, pmtdomain.SourceCSV, from, to, settlements)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	return rec, nil
+}
+
+func (s *reconcileService) reconcile(ctx context.Context, source string, from time.Time, to time.Time, settlements []pmtdomain.Settlement) (pmtdomain.Reconciliation, error) {
+	if !from.Before(to) {
+		return pmtdomain.Reconciliation{}, fmt.Errorf("reconciliation period is empty: %s to %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	// payments of the period, plus older ones the report settled late
+	collected, err := s.repo.ListCollected(ctx, from, to)
+	if err != nil {
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	known := make(map[string]bool, len(collected))
+	for _, p := range collected {
+		known[p.ReceiptID] = true
+	}
+	var outside []string
+	for _, st := range settlements {
+		if !known[st.ReceiptID] {
+			outside = append(outside, st.ReceiptID)
+		}
+	}
+	late, err := s.repo.ListByReceipts(ctx, outside)
+	if err != nil {
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	return s.repo.SaveReconciliation(ctx, pmtdomain.Reconciliation{
+		Source:     source,
+		PeriodFrom: from.UTC(),
+		PeriodTo:   to.UTC(),
+		Checked:    len(settlements),
+		Mismatches: pmtdomain.Reconcile(append(collected, late...), settlements),
+	})
+}
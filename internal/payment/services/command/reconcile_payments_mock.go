@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/command/reconcile_payments.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconcileService is a mock of ReconcileService interface.
+type MockReconcileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconcileServiceMockRecorder
+}
+
+// MockReconcileServiceMockRecorder is the mock recorder for MockReconcileService.
+type MockReconcileServiceMockRecorder struct {
+	mock *MockReconcileService
+}
+
+// NewMockReconcileService creates a new mock instance.
+func NewMockReconcileService(ctrl *gomock.Controller) *MockReconcileService {
+	mock := &MockReconcileService{ctrl: ctrl}
+	mock.recorder = &MockReconcileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconcileService) EXPECT() *MockReconcileServiceMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockReconcileService) Reconcile(ctx context.Context, from, to time.Time) (domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, from, to)
+	ret0, _ := ret[0].(domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconcileServiceMockRecorder) Reconcile(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconcileService)(nil).Reconcile), ctx, from, to)
+}
+
+// ReconcileReport mocks base method.
+func (m *MockReconcileService) ReconcileReport(ctx context.Context, from, to time.Time, settlements []domain.Settlement) (domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileReport", ctx, from, to, settlements)
+	ret0, _ := ret[0].(domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileReport indicates an expected call of ReconcileReport.
+func (mr *MockReconcileServiceMockRecorder) ReconcileReport(ctx, from, to, settlements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileReport", reflect.TypeOf((*MockReconcileService)(nil).ReconcileReport), ctx, from, to, settlements)
+}
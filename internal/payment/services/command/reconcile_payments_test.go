@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"r2-challenge/internal/order/adapters/payment"
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestReconcile_StoresMismatches(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := pmtdb.NewMockRepository(ctrl)
+	processor := payment.NewMockProcessor(ctrl)
+	s, _ := NewReconcileService(repo, processor, tracer)
+
+	to := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-24 * time.Hour)
+
+	processor.EXPECT().Settlements(gomock.Any(), from, to).Return([]pmtdomain.Settlement{
+		{ReceiptID: "r1", AmountCents: 1000, Status: pmtdomain.StatusCaptured},
+		{ReceiptID: "r2", AmountCents: 900, Status: pmtdomain.StatusCaptured},
+		{ReceiptID: "r_old", AmountCents: 500, Status: pmtdomain.StatusRefunded},
+		{ReceiptID: "r_unknown", AmountCents: 700, Status: pmtdomain.StatusCaptured},
+	}, nil)
+	repo.EXPECT().ListCollected(gomock.Any(), from, to).Return([]pmtdomain.Payment{
+		{ID: "p1", ReceiptID: "r1", AmountCents: 1000, Status: pmtdomain.StatusCaptured},
+		{ID: "p2", ReceiptID: "r2", AmountCents: 1000, Status: pmtdomain.StatusCaptured},
+		{ID: "p3", ReceiptID: "r3", AmountCents: 300, Status: pmtdomain.StatusCaptured},
+	}, nil)
+	// receipts settled in this report but created before the period
+	repo.EXPECT().ListByReceipts(gomock.Any(), []string{"r_old", "r_unknown"}).Return([]pmtdomain.Payment{
+		{ID: "p0", ReceiptID: "r_old", AmountCents: 500, Status: pmtdomain.StatusRefunded},
+	}, nil)
+	repo.EXPECT().SaveReconciliation(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r pmtdomain.Reconciliation) (pmtdomain.Reconciliation, error) {
+		r.ID = "rec1"
+		return r, nil
+	})
+
+	rec, err := s.Reconcile(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if rec.Source != pmtdomain.SourceProcessor || rec.Checked != 4 {
+		t.Fatalf("unexpected reconciliation: %+v", rec)
+	}
+
+	want := []pmtdomain.Mismatch{
+		{Kind: pmtdomain.MismatchAmount, ReceiptID: "r2", PaymentID: "p2"},
+		{Kind: pmtdomain.MismatchMissingSettlement, ReceiptID: "r3", PaymentID: "p3"},
+		{Kind: pmtdomain.MismatchMissingPayment, ReceiptID: "r_unknown"},
+	}
+	if len(rec.Mismatches) != len(want) {
+		t.Fatalf("expected %d mismatches, got %+v", len(want), rec.Mismatches)
+	}
+	for i, m := range rec.Mismatches {
+		if m.Kind != want[i].Kind || m.ReceiptID != want[i].ReceiptID || m.PaymentID != want[i].PaymentID {
+			t.Fatalf("mismatch %d: expected %+v, got %+v", i, want[i], m)
+		}
+	}
+}
+
+func TestReconcile_NoSettlementReport(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := pmtdb.NewMockRepository(ctrl)
+	processor := payment.NewMockProcessor(ctrl)
+	s, _ := NewReconcileService(repo, processor, tracer)
+
+	processor.EXPECT().Settlements(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, pmtdomain.ErrNoSettlementReport)
+
+	now := time.Now()
+	if _, err := s.Reconcile(context.Background(), now.Add(-time.Hour), now); !errors.Is(err, pmtdomain.ErrNoSettlementReport) {
+		t.Fatalf("expected ErrNoSettlementReport, got %v", err)
+	}
+}
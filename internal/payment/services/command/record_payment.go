@@ -10,6 +10,8 @@ import (
 
 type RecordService interface {
 	Record(ctx context.Context, payment pmtdomain.Payment) (pmtdomain.Payment, error)
+	// Resolve records the provider's answer on a pending payment.
+	Resolve(ctx context.Context, payment pmtdomain.Payment) (pmtdomain.Payment, error)
 }
 
 type service struct {
@@ -33,3 +35,16 @@ func (s *service) Record(ctx context.Context, payment pmtdomain.Payment) (pmtdom
 
 	return saved, nil
 }
+
+func (s *service) Resolve(ctx context.Context, payment pmtdomain.Payment) (pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.Resolve")
+	defer span.End()
+
+	resolved, err := s.repo.Resolve(ctx, payment)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return resolved, nil
+}
@@ -49,3 +49,18 @@ func (mr *MockRecordServiceMockRecorder) Record(ctx, payment interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRecordService)(nil).Record), ctx, payment)
 }
+
+// Resolve mocks base method.
+func (m *MockRecordService) Resolve(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, payment)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockRecordServiceMockRecorder) Resolve(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockRecordService)(nil).Resolve), ctx, payment)
+}
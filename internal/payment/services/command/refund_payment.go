@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type RefundService interface {
+	// Refund records refunds for amountCents against the order's captured
+	// payments, zero meaning everything still refundable, and returns them so
+	// the caller can reverse them with the processor.
+	Refund(ctx context.Context, orderID string, amountCents int64, reason string) (pmtdomain.RefundResult, error)
+}
+
+type refundService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewRefundService(r pmtdb.Repository, t observability.Tracer) (RefundService, error) {
+	return &refundService{repo: r, tracer: t}, nil
+}
+
+func (s *refundService) Refund(ctx context.Context, orderID string, amountCents int64, reason string) (pmtdomain.RefundResult, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentCommand.Refund")
+	defer span.End()
+
+	if amountCents < 0 {
+		err := fmt.Errorf("refund amount must not be negative: %d", amountCents)
+		span.RecordError(err)
+		return pmtdomain.RefundResult{}, err
+	}
+
+	result, err := s.repo.RefundOrder(ctx, orderID, amountCents, reason)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.RefundResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/command/refund_payment.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRefundService is a mock of RefundService interface.
+type MockRefundService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundServiceMockRecorder
+}
+
+// MockRefundServiceMockRecorder is the mock recorder for MockRefundService.
+type MockRefundServiceMockRecorder struct {
+	mock *MockRefundService
+}
+
+// NewMockRefundService creates a new mock instance.
+func NewMockRefundService(ctrl *gomock.Controller) *MockRefundService {
+	mock := &MockRefundService{ctrl: ctrl}
+	mock.recorder = &MockRefundServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundService) EXPECT() *MockRefundServiceMockRecorder {
+	return m.recorder
+}
+
+// Refund mocks base method.
+func (m *MockRefundService) Refund(ctx context.Context, orderID string, amountCents int64, reason string) (domain.RefundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, orderID, amountCents, reason)
+	ret0, _ := ret[0].(domain.RefundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockRefundServiceMockRecorder) Refund(ctx, orderID, amountCents, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockRefundService)(nil).Refund), ctx, orderID, amountCents, reason)
+}
@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetPaymentService interface {
+	GetByID(ctx context.Context, id string) (pmtdomain.Payment, error)
+}
+
+type getPaymentService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewGetPaymentService(r pmtdb.Repository, t observability.Tracer) (GetPaymentService, error) {
+	return &getPaymentService{repo: r, tracer: t}, nil
+}
+
+func (s *getPaymentService) GetByID(ctx context.Context, id string) (pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentQuery.GetByID")
+	defer span.End()
+
+	payment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Payment{}, err
+	}
+
+	return payment, nil
+}
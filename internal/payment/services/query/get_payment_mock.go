@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/query/get_payment.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetPaymentService is a mock of GetPaymentService interface.
+type MockGetPaymentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPaymentServiceMockRecorder
+}
+
+// MockGetPaymentServiceMockRecorder is the mock recorder for MockGetPaymentService.
+type MockGetPaymentServiceMockRecorder struct {
+	mock *MockGetPaymentService
+}
+
+// NewMockGetPaymentService creates a new mock instance.
+func NewMockGetPaymentService(ctrl *gomock.Controller) *MockGetPaymentService {
+	mock := &MockGetPaymentService{ctrl: ctrl}
+	mock.recorder = &MockGetPaymentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPaymentService) EXPECT() *MockGetPaymentServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockGetPaymentService) GetByID(ctx context.Context, id string) (domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetPaymentServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetPaymentService)(nil).GetByID), ctx, id)
+}
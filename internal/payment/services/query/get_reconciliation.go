@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetReconciliationService interface {
+	GetByID(ctx context.Context, id string) (pmtdomain.Reconciliation, error)
+}
+
+type getReconciliationService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewGetReconciliationService(r pmtdb.Repository, t observability.Tracer) (GetReconciliationService, error) {
+	return &getReconciliationService{repo: r, tracer: t}, nil
+}
+
+func (s *getReconciliationService) GetByID(ctx context.Context, id string) (pmtdomain.Reconciliation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentQuery.GetReconciliation")
+	defer span.End()
+
+	rec, err := s.repo.GetReconciliation(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Reconciliation{}, err
+	}
+
+	return rec, nil
+}
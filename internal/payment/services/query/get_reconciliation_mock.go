@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/query/get_reconciliation.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetReconciliationService is a mock of GetReconciliationService interface.
+type MockGetReconciliationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetReconciliationServiceMockRecorder
+}
+
+// MockGetReconciliationServiceMockRecorder is the mock recorder for MockGetReconciliationService.
+type MockGetReconciliationServiceMockRecorder struct {
+	mock *MockGetReconciliationService
+}
+
+// NewMockGetReconciliationService creates a new mock instance.
+func NewMockGetReconciliationService(ctrl *gomock.Controller) *MockGetReconciliationService {
+	mock := &MockGetReconciliationService{ctrl: ctrl}
+	mock.recorder = &MockGetReconciliationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetReconciliationService) EXPECT() *MockGetReconciliationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockGetReconciliationService) GetByID(ctx context.Context, id string) (domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetReconciliationServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetReconciliationService)(nil).GetByID), ctx, id)
+}
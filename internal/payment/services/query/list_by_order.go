@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListByOrderService interface {
+	// ListByOrder returns the order's payments, oldest first.
+	ListByOrder(ctx context.Context, orderID string) ([]pmtdomain.Payment, error)
+}
+
+type listByOrderService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewListByOrderService(r pmtdb.Repository, t observability.Tracer) (ListByOrderService, error) {
+	return &listByOrderService{repo: r, tracer: t}, nil
+}
+
+func (s *listByOrderService) ListByOrder(ctx context.Context, orderID string) ([]pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentQuery.ListByOrder")
+	defer span.End()
+
+	list, err := s.repo.ListByOrder(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/query/list_by_order.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListByOrderService is a mock of ListByOrderService interface.
+type MockListByOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListByOrderServiceMockRecorder
+}
+
+// MockListByOrderServiceMockRecorder is the mock recorder for MockListByOrderService.
+type MockListByOrderServiceMockRecorder struct {
+	mock *MockListByOrderService
+}
+
+// NewMockListByOrderService creates a new mock instance.
+func NewMockListByOrderService(ctrl *gomock.Controller) *MockListByOrderService {
+	mock := &MockListByOrderService{ctrl: ctrl}
+	mock.recorder = &MockListByOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListByOrderService) EXPECT() *MockListByOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// ListByOrder mocks base method.
+func (m *MockListByOrderService) ListByOrder(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrder", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrder indicates an expected call of ListByOrder.
+func (mr *MockListByOrderServiceMockRecorder) ListByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrder", reflect.TypeOf((*MockListByOrderService)(nil).ListByOrder), ctx, orderID)
+}
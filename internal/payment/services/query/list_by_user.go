@@ -0,0 +1,37 @@
+package query
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListByUserService interface {
+	// ListByUser returns the user's payments, newest first; an empty userID
+	// lists the payments of every user.
+	ListByUser(ctx context.Context, userID string, filter pmtdb.PaymentFilter) ([]pmtdomain.Payment, error)
+}
+
+type listByUserService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewListByUserService(r pmtdb.Repository, t observability.Tracer) (ListByUserService, error) {
+	return &listByUserService{repo: r, tracer: t}, nil
+}
+
+func (s *listByUserService) ListByUser(ctx context.Context, userID string, filter pmtdb.PaymentFilter) ([]pmtdomain.Payment, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentQuery.ListByUser")
+	defer span.End()
+
+	list, err := s.repo.ListByUser(ctx, userID, filter)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListReconciliationsService interface {
+	// List returns stored reconciliations, newest first.
+	List(ctx context.Context, limit int, offset int) ([]pmtdomain.Reconciliation, error)
+}
+
+type listReconciliationsService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewListReconciliationsService(r pmtdb.Repository, t observability.Tracer) (ListReconciliationsService, error) {
+	return &listReconciliationsService{repo: r, tracer: t}, nil
+}
+
+func (s *listReconciliationsService) List(ctx context.Context, limit int, offset int) ([]pmtdomain.Reconciliation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentQuery.ListReconciliations")
+	defer span.End()
+
+	list, err := s.repo.ListReconciliations(ctx, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/query/list_reconciliations.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListReconciliationsService is a mock of ListReconciliationsService interface.
+type MockListReconciliationsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListReconciliationsServiceMockRecorder
+}
+
+// MockListReconciliationsServiceMockRecorder is the mock recorder for MockListReconciliationsService.
+type MockListReconciliationsServiceMockRecorder struct {
+	mock *MockListReconciliationsService
+}
+
+// NewMockListReconciliationsService creates a new mock instance.
+func NewMockListReconciliationsService(ctrl *gomock.Controller) *MockListReconciliationsService {
+	mock := &MockListReconciliationsService{ctrl: ctrl}
+	mock.recorder = &MockListReconciliationsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListReconciliationsService) EXPECT() *MockListReconciliationsServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListReconciliationsService) List(ctx context.Context, limit, offset int) ([]domain.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListReconciliationsServiceMockRecorder) List(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListReconciliationsService)(nil).List), ctx, limit, offset)
+}
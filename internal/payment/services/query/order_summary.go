@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type OrderSummaryService interface {
+	// Summary aggregates what was authorized, captured and refunded for the order.
+	Summary(ctx context.Context, orderID string) (pmtdomain.Summary, error)
+}
+
+type orderSummaryService struct {
+	repo   pmtdb.Repository
+	tracer observability.Tracer
+}
+
+func NewOrderSummaryService(r pmtdb.Repository, t observability.Tracer) (OrderSummaryService, error) {
+	return &orderSummaryService{repo: r, tracer: t}, nil
+}
+
+func (s *orderSummaryService) Summary(ctx context.Context, orderID string) (pmtdomain.Summary, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PaymentQuery.Summary")
+	defer span.End()
+
+	payments, err := s.repo.ListByOrder(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Summary{}, err
+	}
+
+	refunds, err := s.repo.ListRefundsByOrder(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		return pmtdomain.Summary{}, err
+	}
+
+	return pmtdomain.Summarize(payments, refunds), nil
+}
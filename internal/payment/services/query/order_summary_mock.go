@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payment/services/query/order_summary.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/payment/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderSummaryService is a mock of OrderSummaryService interface.
+type MockOrderSummaryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderSummaryServiceMockRecorder
+}
+
+// MockOrderSummaryServiceMockRecorder is the mock recorder for MockOrderSummaryService.
+type MockOrderSummaryServiceMockRecorder struct {
+	mock *MockOrderSummaryService
+}
+
+// NewMockOrderSummaryService creates a new mock instance.
+func NewMockOrderSummaryService(ctrl *gomock.Controller) *MockOrderSummaryService {
+	mock := &MockOrderSummaryService{ctrl: ctrl}
+	mock.recorder = &MockOrderSummaryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderSummaryService) EXPECT() *MockOrderSummaryServiceMockRecorder {
+	return m.recorder
+}
+
+// Summary mocks base method.
+func (m *MockOrderSummaryService) Summary(ctx context.Context, orderID string) (domain.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary", ctx, orderID)
+	ret0, _ := ret[0].(domain.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockOrderSummaryServiceMockRecorder) Summary(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockOrderSummaryService)(nil).Summary), ctx, orderID)
+}
@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	pmtdb "r2-challenge/internal/payment/adapters/db"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestOrderSummary_AggregatesPaymentsAndRefunds(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := pmtdb.NewMockRepository(ctrl)
+	s, _ := NewOrderSummaryService(repo, tracer)
+
+	repo.EXPECT().ListByOrder(gomock.Any(), "o1").Return([]pmtdomain.Payment{
+		{AmountCents: 1000, Status: pmtdomain.StatusFailed},
+		{AmountCents: 1000, Status: pmtdomain.StatusPartiallyRefunded},
+	}, nil)
+	repo.EXPECT().ListRefundsByOrder(gomock.Any(), "o1").Return([]pmtdomain.Refund{{AmountCents: 250}}, nil)
+
+	res, err := s.Summary(context.Background(), "o1")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	want := pmtdomain.Summary{Status: pmtdomain.StatusPartiallyRefunded, CapturedCents: 1000, RefundedCents: 250, NetCents: 750, Payments: 2}
+	if res != want {
+		t.Fatalf("unexpected summary: %+v", res)
+	}
+}
+
+func TestOrderSummary_NoPayments(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := pmtdb.NewMockRepository(ctrl)
+	s, _ := NewOrderSummaryService(repo, tracer)
+
+	repo.EXPECT().ListByOrder(gomock.Any(), "o1").Return(nil, nil)
+	repo.EXPECT().ListRefundsByOrder(gomock.Any(), "o1").Return(nil, nil)
+
+	res, err := s.Summary(context.Background(), "o1")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if res != (pmtdomain.Summary{}) {
+		t.Fatalf("expected empty summary, got %+v", res)
+	}
+}
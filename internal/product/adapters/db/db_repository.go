@@ -14,6 +14,12 @@ import (
 	"r2-challenge/pkg/observability"
 )
 
+// productColumns selects the product plus its stock not held by active reservations.
+const productColumns = `products.*, products.inventory - COALESCE((
+	SELECT SUM(r.quantity) FROM reservations r
+	WHERE r.product_id = products.id AND r.status = 'active' AND r.expires_at > NOW()
+), 0) AS available`
+
 type dbProductRepository struct {
 	db     *gorm.DB
 	tracer observability.Tracer
@@ -48,12 +54,13 @@ func (r *dbProductRepository) Update(ctx context.Context, product domain.Product
 
 	product.UpdatedAt = time.Now().UTC()
 	tx := r.db.WithContext(ctx).Table("products").Where("id = ?", product.ID).Updates(map[string]any{
-		"name":        product.Name,
-		"description": product.Description,
-		"category":    product.Category,
-		"price_cents": product.PriceCents,
-		"inventory":   product.Inventory,
-		"updated_at":  product.UpdatedAt,
+		"name":         product.Name,
+		"description":  product.Description,
+		"category":     product.Category,
+		"price_cents":  product.PriceCents,
+		"inventory":    product.Inventory,
+		"weight_grams": product.WeightGrams,
+		"updated_at":   product.UpdatedAt,
 	})
 	if tx.Error != nil {
 		span.RecordError(tx.Error)
@@ -89,7 +96,7 @@ func (r *dbProductRepository) GetByID(ctx context.Context, productID string) (do
 	defer span.End()
 
 	var product domain.Product
-	err := r.db.WithContext(ctx).Table("products").Where("id = ?", productID).First(&product).Error
+	err := r.db.WithContext(ctx).Table("products").Select(productColumns).Where("id = ?", productID).First(&product).Error
 	if err != nil {
 		span.RecordError(err)
 		return domain.Product{}, err
@@ -103,7 +110,7 @@ func (r *dbProductRepository) List(ctx context.Context, filter ProductFilter) ([
 	defer span.End()
 
 	var list []domain.Product
-	q := r.db.WithContext(ctx).Table("products")
+	q := r.db.WithContext(ctx).Table("products").Select(productColumns)
 
 	if filter.Category != "" {
 		q = q.Where("category = ?", filter.Category)
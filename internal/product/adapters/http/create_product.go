@@ -27,6 +27,7 @@ type createProductRequest struct {
 	Category    string `json:"category" validate:"required"`
 	PriceCents  int64  `json:"price_cents" validate:"required,gte=0"`
 	Inventory   int64  `json:"inventory" validate:"gte=0"`
+	WeightGrams int64  `json:"weight_grams" validate:"gte=0"`
 }
 
 // Create Product
@@ -60,6 +61,7 @@ func (h CreateHandler) Handle(c echo.Context) error {
 		Category:    req.Category,
 		PriceCents:  req.PriceCents,
 		Inventory:   req.Inventory,
+		WeightGrams: req.WeightGrams,
 	}
 
 	created, err := h.service.Create(ctx, prod)
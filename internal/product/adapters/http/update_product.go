@@ -27,6 +27,7 @@ type updateProductRequest struct {
 	Category    string `json:"category" validate:"required"`
 	PriceCents  int64  `json:"price_cents" validate:"required,gte=0"`
 	Inventory   int64  `json:"inventory" validate:"gte=0"`
+	WeightGrams int64  `json:"weight_grams" validate:"gte=0"`
 }
 
 // Update Product
@@ -61,7 +62,7 @@ func (h UpdateHandler) Handle(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	product := domain.Product{ID: productID, Name: req.Name, Description: req.Description, Category: req.Category, PriceCents: req.PriceCents, Inventory: req.Inventory}
+	product := domain.Product{ID: productID, Name: req.Name, Description: req.Description, Category: req.Category, PriceCents: req.PriceCents, Inventory: req.Inventory, WeightGrams: req.WeightGrams}
 	updated, err := h.service.Update(ctx, product)
 	if err != nil {
 		span.RecordError(err)
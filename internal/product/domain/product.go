@@ -9,6 +9,8 @@ type Product struct {
 	Category    string     `json:"category" validate:"required"`
 	PriceCents  int64      `json:"price_cents" validate:"required,gte=0"`
 	Inventory   int64      `json:"inventory" validate:"gte=0"`
+	WeightGrams int64      `json:"weight_grams" validate:"gte=0"` // shipping weight of one unit
+	Available   int64      `json:"available" gorm:"->"`           // inventory minus active reservations; read-only
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	DeletedAt   *time.Time `json:"deleted_at"`
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"r2-challenge/internal/promotion/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbPromotionRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (PromotionRepository, error) {
+	return &dbPromotionRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbPromotionRepository) SaveCoupon(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.SaveCoupon")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if c.ID == "" {
+		c.ID = uuid.NewString()
+	}
+	if c.Categories == nil {
+		c.Categories = []string{}
+	}
+	c.Uses = 0
+	c.CreatedAt = now
+	c.UpdatedAt = now
+
+	if err := appdb.Conn(ctx, r.db).Table("coupons").Create(&c).Error; err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return c, nil
+}
+
+func (r *dbPromotionRepository) UpdateCoupon(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.UpdateCoupon")
+	defer span.End()
+
+	if c.Categories == nil {
+		c.Categories = []string{}
+	}
+	c.UpdatedAt = time.Now().UTC()
+	columns := []string{
+		"type", "percent_off", "amount_off_cents", "free_product_id", "free_quantity", "min_spend_cents",
+		"categories", "max_uses", "max_uses_per_user", "starts_at", "ends_at", "active", "updated_at",
+	}
+
+	tx := appdb.Conn(ctx, r.db).Table("coupons").Where("id = ?", c.ID).Select(columns).Updates(&c)
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return domain.Coupon{}, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return domain.Coupon{}, gorm.ErrRecordNotFound
+	}
+
+	return r.GetCoupon(ctx, c.ID)
+}
+
+func (r *dbPromotionRepository) GetCoupon(ctx context.Context, id string) (domain.Coupon, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.GetCoupon")
+	defer span.End()
+
+	var c domain.Coupon
+	if err := appdb.Conn(ctx, r.db).Table("coupons").Where("id = ?", id).First(&c).Error; err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return c, nil
+}
+
+func (r *dbPromotionRepository) GetCouponByCode(ctx context.Context, code string) (domain.Coupon, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.GetCouponByCode")
+	defer span.End()
+
+	var c domain.Coupon
+	if err := appdb.Conn(ctx, r.db).Table("coupons").Where("code = ?", code).First(&c).Error; err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return c, nil
+}
+
+func (r *dbPromotionRepository) LockCouponByCode(ctx context.Context, code string) (domain.Coupon, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.LockCouponByCode")
+	defer span.End()
+
+	var c domain.Coupon
+	if err := appdb.Conn(ctx, r.db).Table("coupons").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", code).
+		First(&c).Error; err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return c, nil
+}
+
+func (r *dbPromotionRepository) ListCoupons(ctx context.Context, limit int, offset int) ([]domain.Coupon, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.ListCoupons")
+	defer span.End()
+
+	q := appdb.Conn(ctx, r.db).Table("coupons").Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	var list []domain.Coupon
+	if err := q.Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *dbPromotionRepository) CountRedemptions(ctx context.Context, couponID string, userID string) (int64, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.CountRedemptions")
+	defer span.End()
+
+	var n int64
+	if err := appdb.Conn(ctx, r.db).Table("coupon_redemptions").
+		Where("coupon_id = ? AND user_id = ?", couponID, userID).
+		Count(&n).Error; err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *dbPromotionRepository) SaveRedemption(ctx context.Context, red domain.Redemption) (domain.Redemption, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.SaveRedemption")
+	defer span.End()
+
+	if red.ID == "" {
+		red.ID = uuid.NewString()
+	}
+	red.CreatedAt = time.Now().UTC()
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("coupon_redemptions").Create(&red).Error; err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE coupons SET uses = uses + 1 WHERE id = ?", red.CouponID).Error
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Redemption{}, err
+	}
+
+	return red, nil
+}
+
+func (r *dbPromotionRepository) DeleteRedemption(ctx context.Context, orderID string) error {
+	ctx, span := r.tracer.StartSpan(ctx, "PromotionRepository.DeleteRedemption")
+	defer span.End()
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var deleted []domain.Redemption
+		if err := tx.Raw("DELETE FROM coupon_redemptions WHERE order_id = ? RETURNING *", orderID).Scan(&deleted).Error; err != nil {
+			return err
+		}
+		for _, d := range deleted {
+			if err := tx.Exec("UPDATE coupons SET uses = GREATEST(uses - 1, 0) WHERE id = ?", d.CouponID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
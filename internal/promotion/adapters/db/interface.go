@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/promotion/domain"
+)
+
+type PromotionRepository interface {
+	SaveCoupon(ctx context.Context, c domain.Coupon) (domain.Coupon, error)
+	// UpdateCoupon replaces the coupon definition; its code and usage count
+	// are kept.
+	UpdateCoupon(ctx context.Context, c domain.Coupon) (domain.Coupon, error)
+	GetCoupon(ctx context.Context, id string) (domain.Coupon, error)
+	// GetCouponByCode returns gorm.ErrRecordNotFound for an unknown code.
+	GetCouponByCode(ctx context.Context, code string) (domain.Coupon, error)
+	// LockCouponByCode is GetCouponByCode with the row locked until the
+	// surrounding transaction ends, so usage limits hold under concurrency.
+	LockCouponByCode(ctx context.Context, code string) (domain.Coupon, error)
+	ListCoupons(ctx context.Context, limit int, offset int) ([]domain.Coupon, error)
+	// CountRedemptions returns how many orders of the user redeemed the coupon.
+	CountRedemptions(ctx context.Context, couponID string, userID string) (int64, error)
+	// SaveRedemption records the redemption and counts it against the coupon.
+	SaveRedemption(ctx context.Context, r domain.Redemption) (domain.Redemption, error)
+	// DeleteRedemption removes the order's redemption, if any, and gives the
+	// use back to the coupon.
+	DeleteRedemption(ctx context.Context, orderID string) error
+}
@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotion/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/promotion/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromotionRepository is a mock of PromotionRepository interface.
+type MockPromotionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionRepositoryMockRecorder
+}
+
+// MockPromotionRepositoryMockRecorder is the mock recorder for MockPromotionRepository.
+type MockPromotionRepositoryMockRecorder struct {
+	mock *MockPromotionRepository
+}
+
+// NewMockPromotionRepository creates a new mock instance.
+func NewMockPromotionRepository(ctrl *gomock.Controller) *MockPromotionRepository {
+	mock := &MockPromotionRepository{ctrl: ctrl}
+	mock.recorder = &MockPromotionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionRepository) EXPECT() *MockPromotionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountRedemptions mocks base method.
+func (m *MockPromotionRepository) CountRedemptions(ctx context.Context, couponID, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRedemptions", ctx, couponID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRedemptions indicates an expected call of CountRedemptions.
+func (mr *MockPromotionRepositoryMockRecorder) CountRedemptions(ctx, couponID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRedemptions", reflect.TypeOf((*MockPromotionRepository)(nil).CountRedemptions), ctx, couponID, userID)
+}
+
+// DeleteRedemption mocks base method.
+func (m *MockPromotionRepository) DeleteRedemption(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRedemption", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRedemption indicates an expected call of DeleteRedemption.
+func (mr *MockPromotionRepositoryMockRecorder) DeleteRedemption(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRedemption", reflect.TypeOf((*MockPromotionRepository)(nil).DeleteRedemption), ctx, orderID)
+}
+
+// GetCoupon mocks base method.
+func (m *MockPromotionRepository) GetCoupon(ctx context.Context, id string) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoupon", ctx, id)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoupon indicates an expected call of GetCoupon.
+func (mr *MockPromotionRepositoryMockRecorder) GetCoupon(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoupon", reflect.TypeOf((*MockPromotionRepository)(nil).GetCoupon), ctx, id)
+}
+
+// GetCouponByCode mocks base method.
+func (m *MockPromotionRepository) GetCouponByCode(ctx context.Context, code string) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCouponByCode", ctx, code)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCouponByCode indicates an expected call of GetCouponByCode.
+func (mr *MockPromotionRepositoryMockRecorder) GetCouponByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCouponByCode", reflect.TypeOf((*MockPromotionRepository)(nil).GetCouponByCode), ctx, code)
+}
+
+// ListCoupons mocks base method.
+func (m *MockPromotionRepository) ListCoupons(ctx context.Context, limit, offset int) ([]domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCoupons", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoupons indicates an expected call of ListCoupons.
+func (mr *MockPromotionRepositoryMockRecorder) ListCoupons(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoupons", reflect.TypeOf((*MockPromotionRepository)(nil).ListCoupons), ctx, limit, offset)
+}
+
+// LockCouponByCode mocks base method.
+func (m *MockPromotionRepository) LockCouponByCode(ctx context.Context, code string) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCouponByCode", ctx, code)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCouponByCode indicates an expected call of LockCouponByCode.
+func (mr *MockPromotionRepositoryMockRecorder) LockCouponByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCouponByCode", reflect.TypeOf((*MockPromotionRepository)(nil).LockCouponByCode), ctx, code)
+}
+
+// SaveCoupon mocks base method.
+func (m *MockPromotionRepository) SaveCoupon(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCoupon", ctx, c)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCoupon indicates an expected call of SaveCoupon.
+func (mr *MockPromotionRepositoryMockRecorder) SaveCoupon(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCoupon", reflect.TypeOf((*MockPromotionRepository)(nil).SaveCoupon), ctx, c)
+}
+
+// SaveRedemption mocks base method.
+func (m *MockPromotionRepository) SaveRedemption(ctx context.Context, r domain.Redemption) (domain.Redemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRedemption", ctx, r)
+	ret0, _ := ret[0].(domain.Redemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveRedemption indicates an expected call of SaveRedemption.
+func (mr *MockPromotionRepositoryMockRecorder) SaveRedemption(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRedemption", reflect.TypeOf((*MockPromotionRepository)(nil).SaveRedemption), ctx, r)
+}
+
+// UpdateCoupon mocks base method.
+func (m *MockPromotionRepository) UpdateCoupon(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCoupon", ctx, c)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCoupon indicates an expected call of UpdateCoupon.
+func (mr *MockPromotionRepositoryMockRecorder) UpdateCoupon(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCoupon", reflect.TypeOf((*MockPromotionRepository)(nil).UpdateCoupon), ctx, c)
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/internal/promotion/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateCouponHandler struct {
+	service   command.CreateCouponService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCreateCouponHandler(s command.CreateCouponService, v *validator.Validate, t observability.Tracer) (CreateCouponHandler, error) {
+	return CreateCouponHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type couponRequest struct {
+	// ignored on update; codes cannot change
+	Code           string     `json:"code" validate:"omitempty,max=64"`
+	Type           string     `json:"type" validate:"required,oneof=percentage fixed free_item"`
+	PercentOff     int64      `json:"percent_off" validate:"gte=0,lte=100"`
+	AmountOffCents int64      `json:"amount_off_cents" validate:"gte=0"`
+	FreeProductID  string     `json:"free_product_id" validate:"omitempty,uuid"`
+	FreeQuantity   int64      `json:"free_quantity" validate:"gte=0"`
+	MinSpendCents  int64      `json:"min_spend_cents" validate:"gte=0"`
+	Categories     []string   `json:"categories" validate:"dive,required"`
+	MaxUses        int64      `json:"max_uses" validate:"gte=0"`
+	MaxUsesPerUser int64      `json:"max_uses_per_user" validate:"gte=0"`
+	StartsAt       *time.Time `json:"starts_at"`
+	EndsAt         *time.Time `json:"ends_at"`
+	// defaults to true
+	Active *bool `json:"active"`
+}
+
+func (r couponRequest) toDomain(id string) domain.Coupon {
+	active := true
+	if r.Active != nil {
+		active = *r.Active
+	}
+	freeQuantity := r.FreeQuantity
+	if r.Type == domain.TypeFreeItem && freeQuantity == 0 {
+		freeQuantity = 1
+	}
+	return domain.Coupon{
+		ID:             id,
+		Code:           r.Code,
+		Type:           r.Type,
+		PercentOff:     r.PercentOff,
+		AmountOffCents: r.AmountOffCents,
+		FreeProductID:  r.FreeProductID,
+		FreeQuantity:   freeQuantity,
+		MinSpendCents:  r.MinSpendCents,
+		Categories:     r.Categories,
+		MaxUses:        r.MaxUses,
+		MaxUsesPerUser: r.MaxUsesPerUser,
+		StartsAt:       r.StartsAt,
+		EndsAt:         r.EndsAt,
+		Active:         active,
+	}
+}
+
+// Create Coupon
+// @Summary      Create coupon
+// @Description  Create a coupon code (admin only). Codes are case-insensitive and stored upper-cased
+// @Tags         Coupons
+// @Accept       json
+// @Produce      json
+// @Param        coupon  body     couponRequest  true  "Coupon input"
+// @Success      201     {object} domain.Coupon
+// @Failure      400     {object} map[string]string "Bad Request"
+// @Failure      401     {object} map[string]string "Unauthorized"
+// @Failure      403     {object} map[string]string "Forbidden"
+// @Failure      409     {object} map[string]string "Code already exists"
+// @Failure      500     {object} map[string]string "Internal Server Error"
+// @Router       /coupons [post]
+func (h CreateCouponHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PromotionHTTP.CreateCoupon")
+	defer span.End()
+
+	var req couponRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if err := h.validator.Var(req.Code, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "code is required"})
+	}
+
+	created, err := h.service.Create(ctx, req.toDomain(""))
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, created)
+}
+
+func writeError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	case errors.Is(err, domain.ErrInvalidCoupon):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrCodeTaken):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
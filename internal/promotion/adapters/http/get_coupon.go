@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/promotion/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type GetCouponHandler struct {
+	service   query.GetCouponService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetCouponHandler(s query.GetCouponService, v *validator.Validate, t observability.Tracer) (GetCouponHandler, error) {
+	return GetCouponHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Coupon
+// @Summary      Get coupon
+// @Description  Coupon definition with its usage count (admin only)
+// @Tags         Coupons
+// @Produce      json
+// @Param        id   path     string  true  "Coupon ID"
+// @Success      200  {object} domain.Coupon
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /coupons/{id} [get]
+func (h GetCouponHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PromotionHTTP.GetCoupon")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	coupon, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, coupon)
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/promotion/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListCouponsHandler struct {
+	service   query.ListCouponsService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListCouponsHandler(s query.ListCouponsService, v *validator.Validate, t observability.Tracer) (ListCouponsHandler, error) {
+	return ListCouponsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Coupons
+// @Summary      List coupons
+// @Description  Coupons, newest first (admin only)
+// @Tags         Coupons
+// @Produce      json
+// @Param        limit   query    int  false  "Limit"
+// @Param        offset  query    int  false  "Offset"
+// @Success      200     {array}  domain.Coupon
+// @Failure      401     {object} map[string]string "Unauthorized"
+// @Failure      403     {object} map[string]string "Forbidden"
+// @Failure      500     {object} map[string]string "Internal Server Error"
+// @Router       /coupons [get]
+func (h ListCouponsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PromotionHTTP.ListCoupons")
+	defer span.End()
+
+	limit := 50
+	if s := c.QueryParam("limit"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			limit = v
+		}
+	}
+	offset := 0
+	if s := c.QueryParam("offset"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			offset = v
+		}
+	}
+
+	list, err := h.service.List(ctx, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/promotion/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateCouponHandler struct {
+	service   command.UpdateCouponService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewUpdateCouponHandler(s command.UpdateCouponService, v *validator.Validate, t observability.Tracer) (UpdateCouponHandler, error) {
+	return UpdateCouponHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Update Coupon
+// @Summary      Update coupon
+// @Description  Replace a coupon definition; the code and usage count are kept. Send active=false to disable it (admin only)
+// @Tags         Coupons
+// @Accept       json
+// @Produce      json
+// @Param        id      path     string         true  "Coupon ID"
+// @Param        coupon  body     couponRequest  true  "Coupon input"
+// @Success      200     {object} domain.Coupon
+// @Failure      400     {object} map[string]string "Bad Request"
+// @Failure      401     {object} map[string]string "Unauthorized"
+// @Failure      403     {object} map[string]string "Forbidden"
+// @Failure      404     {object} map[string]string "Not Found"
+// @Failure      500     {object} map[string]string "Internal Server Error"
+// @Router       /coupons/{id} [put]
+func (h UpdateCouponHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "PromotionHTTP.UpdateCoupon")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req couponRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	updated, err := h.service.Update(ctx, req.toDomain(id))
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, updated)
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Coupon types.
+const (
+	TypePercentage = "percentage"
+	TypeFixed      = "fixed"
+	TypeFreeItem   = "free_item"
+)
+
+var (
+	// ErrInvalidCoupon is returned when a coupon definition is inconsistent.
+	ErrInvalidCoupon = errors.New("invalid coupon")
+	ErrCodeTaken     = errors.New("coupon code already exists")
+	// ErrCouponNotApplicable is returned when a coupon cannot discount an order;
+	// the wrapped message says why.
+	ErrCouponNotApplicable = errors.New("coupon cannot be applied")
+)
+
+// Coupon is a discount redeemable with a code. Categories restricts the
+// discount to items of those product categories; empty means every item.
+// Zero usage limits mean unlimited.
+type Coupon struct {
+	ID             string     `json:"id" gorm:"primaryKey;type:uuid"`
+	Code           string     `json:"code"`
+	Type           string     `json:"type"`
+	PercentOff     int64      `json:"percent_off,omitempty"`
+	AmountOffCents int64      `json:"amount_off_cents,omitempty"`
+	FreeProductID  string     `json:"free_product_id,omitempty"`
+	FreeQuantity   int64      `json:"free_quantity,omitempty"`
+	MinSpendCents  int64      `json:"min_spend_cents"`
+	Categories     []string   `json:"categories" gorm:"serializer:json"`
+	MaxUses        int64      `json:"max_uses"`
+	MaxUsesPerUser int64      `json:"max_uses_per_user"`
+	Uses           int64      `json:"uses"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	EndsAt         *time.Time `json:"ends_at,omitempty"`
+	Active         bool       `json:"active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Redemption records a coupon used on an order.
+type Redemption struct {
+	ID            string    `json:"id" gorm:"primaryKey;type:uuid"`
+	CouponID      string    `json:"coupon_id" gorm:"type:uuid"`
+	Code          string    `json:"code"`
+	OrderID       string    `json:"order_id" gorm:"type:uuid"`
+	UserID        string    `json:"user_id" gorm:"type:uuid"`
+	DiscountCents int64     `json:"discount_cents"`
+	CreatedAt     time.Time `json:"created_at"`
+	// LineDiscounts is the discount of each line passed to the redemption, in order.
+	LineDiscounts []int64 `json:"-" gorm:"-"`
+}
+
+// Line is an order line a coupon is applied to.
+type Line struct {
+	ProductID      string
+	Category       string
+	Quantity       int64
+	UnitPriceCents int64
+}
+
+// NormalizeCode makes codes case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate checks that the fields required by the coupon type are set.
+func (c Coupon) Validate() error {
+	if c.Code == "" {
+		return fmt.Errorf("%w: code is required", ErrInvalidCoupon)
+	}
+	switch c.Type {
+	case TypePercentage:
+		if c.PercentOff < 1 || c.PercentOff > 100 {
+			return fmt.Errorf("%w: percent_off must be between 1 and 100", ErrInvalidCoupon)
+		}
+	case TypeFixed:
+		if c.AmountOffCents <= 0 {
+			return fmt.Errorf("%w: amount_off_cents must be positive", ErrInvalidCoupon)
+		}
+	case TypeFreeItem:
+		if c.FreeProductID == "" || c.FreeQuantity <= 0 {
+			return fmt.Errorf("%w: free_product_id and a positive free_quantity are required", ErrInvalidCoupon)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidCoupon, c.Type)
+	}
+	if c.MinSpendCents < 0 || c.MaxUses < 0 || c.MaxUsesPerUser < 0 {
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidCoupon)
+	}
+	if c.StartsAt != nil && c.EndsAt != nil && !c.StartsAt.Before(*c.EndsAt) {
+		return fmt.Errorf("%w: starts_at must be before ends_at", ErrInvalidCoupon)
+	}
+	return nil
+}
+
+// Restricted reports whether the coupon only applies to some categories.
+func (c Coupon) Restricted() bool {
+	return len(c.Categories) > 0
+}
+
+// Discounts checks that the coupon can be redeemed at now by a user who
+// already used it userUses times, and returns the discount of each line.
+// Only lines in the coupon's categories are discounted, and the minimum
+// spend is measured on them.
+func (c Coupon) Discounts(lines []Line, now time.Time, userUses int64) ([]int64, error) {
+	switch {
+	case !c.Active:
+		return nil, fmt.Errorf("%w: inactive", ErrCouponNotApplicable)
+	case c.StartsAt != nil && now.Before(*c.StartsAt):
+		return nil, fmt.Errorf("%w: not valid yet", ErrCouponNotApplicable)
+	case c.EndsAt != nil && !now.Before(*c.EndsAt):
+		return nil, fmt.Errorf("%w: expired", ErrCouponNotApplicable)
+	case c.MaxUses > 0 && c.Uses >= c.MaxUses:
+		return nil, fmt.Errorf("%w: usage limit reached", ErrCouponNotApplicable)
+	case c.MaxUsesPerUser > 0 && userUses >= c.MaxUsesPerUser:
+		return nil, fmt.Errorf("%w: already used", ErrCouponNotApplicable)
+	}
+
+	eligible := make([]bool, len(lines))
+	var eligibleCents int64
+	for i, l := range lines {
+		if c.Restricted() && !containsFold(c.Categories, l.Category) {
+			continue
+		}
+		eligible[i] = true
+		eligibleCents += l.UnitPriceCents * l.Quantity
+	}
+	if eligibleCents == 0 {
+		return nil, fmt.Errorf("%w: no eligible items", ErrCouponNotApplicable)
+	}
+	if eligibleCents < c.MinSpendCents {
+		return nil, fmt.Errorf("%w: minimum spend of %d cents not reached", ErrCouponNotApplicable, c.MinSpendCents)
+	}
+
+	discounts := make([]int64, len(lines))
+	switch c.Type {
+	case TypePercentage:
+		for i, l := range lines {
+			if eligible[i] {
+				discounts[i] = l.UnitPriceCents * l.Quantity * c.PercentOff / 100
+			}
+		}
+	case TypeFixed:
+		allocate(discounts, lines, eligible, eligibleCents, min(c.AmountOffCents, eligibleCents))
+	case TypeFreeItem:
+		free := c.FreeQuantity
+		for i, l := range lines {
+			if !eligible[i] || l.ProductID != c.FreeProductID || free == 0 {
+				continue
+			}
+			n := min(free, l.Quantity)
+			discounts[i] = n * l.UnitPriceCents
+			free -= n
+		}
+		if free == c.FreeQuantity {
+			return nil, fmt.Errorf("%w: free product is not in the order", ErrCouponNotApplicable)
+		}
+	}
+
+	return discounts, nil
+}
+
+// allocate spreads amount over the eligible lines in proportion to their
+// totals, handing the rounding remainder to the first lines so the
+// discounts add up to amount exactly.
+func allocate(discounts []int64, lines []Line, eligible []bool, eligibleCents int64, amount int64) {
+	var given int64
+	for i, l := range lines {
+		if eligible[i] {
+			discounts[i] = amount * l.UnitPriceCents * l.Quantity / eligibleCents
+			given += discounts[i]
+		}
+	}
+	for i, l := range lines {
+		if given == amount {
+			break
+		}
+		if eligible[i] && discounts[i] < l.UnitPriceCents*l.Quantity {
+			discounts[i]++
+			given++
+		}
+	}
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
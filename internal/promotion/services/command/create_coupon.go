@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	repo "r2-challenge/internal/promotion/adapters/db"
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateCouponService interface {
+	// Create stores the coupon under its upper-cased code, failing with
+	// domain.ErrCodeTaken when the code is in use.
+	Create(ctx context.Context, c domain.Coupon) (domain.Coupon, error)
+}
+
+type createCouponService struct {
+	repo   repo.PromotionRepository
+	tracer observability.Tracer
+}
+
+func NewCreateCouponService(r repo.PromotionRepository, t observability.Tracer) (CreateCouponService, error) {
+	return &createCouponService{repo: r, tracer: t}, nil
+}
+
+func (s *createCouponService) Create(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PromotionCommand.CreateCoupon")
+	defer span.End()
+
+	c.Code = domain.NormalizeCode(c.Code)
+	if err := c.Validate(); err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	_, err := s.repo.GetCouponByCode(ctx, c.Code)
+	if err == nil {
+		span.RecordError(domain.ErrCodeTaken)
+		return domain.Coupon{}, domain.ErrCodeTaken
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	saved, err := s.repo.SaveCoupon(ctx, c)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotion/services/command/create_coupon.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/promotion/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateCouponService is a mock of CreateCouponService interface.
+type MockCreateCouponService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateCouponServiceMockRecorder
+}
+
+// MockCreateCouponServiceMockRecorder is the mock recorder for MockCreateCouponService.
+type MockCreateCouponServiceMockRecorder struct {
+	mock *MockCreateCouponService
+}
+
+// NewMockCreateCouponService creates a new mock instance.
+func NewMockCreateCouponService(ctrl *gomock.Controller) *MockCreateCouponService {
+	mock := &MockCreateCouponService{ctrl: ctrl}
+	mock.recorder = &MockCreateCouponServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateCouponService) EXPECT() *MockCreateCouponServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateCouponService) Create(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateCouponServiceMockRecorder) Create(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateCouponService)(nil).Create), ctx, c)
+}
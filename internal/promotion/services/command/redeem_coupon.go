@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	productqry "r2-challenge/internal/product/services/query"
+	repo "r2-challenge/internal/promotion/adapters/db"
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type RedeemService interface {
+	// Redeem applies the coupon to the order lines and records the
+	// redemption. It must run inside the transaction that saves the order:
+	// the coupon stays locked until it commits, so usage limits cannot be
+	// exceeded by concurrent orders. Coupons that do not apply fail with
+	// domain.ErrCouponNotApplicable.
+	Redeem(ctx context.Context, code string, userID string, orderID string, lines []domain.Line) (domain.Redemption, error)
+	// Release gives back the coupon used by an order that was never paid.
+	Release(ctx context.Context, orderID string) error
+}
+
+type redeemService struct {
+	repo     repo.PromotionRepository
+	products productqry.GetByIDService
+	tracer   observability.Tracer
+}
+
+func NewRedeemService(r repo.PromotionRepository, p productqry.GetByIDService, t observability.Tracer) (RedeemService, error) {
+	return &redeemService{repo: r, products: p, tracer: t}, nil
+}
+
+func (s *redeemService) Redeem(ctx context.Context, code string, userID string, orderID string, lines []domain.Line) (domain.Redemption, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PromotionCommand.Redeem")
+	defer span.End()
+
+	coupon, err := s.repo.LockCouponByCode(ctx, domain.NormalizeCode(code))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = fmt.Errorf("%w: unknown code", domain.ErrCouponNotApplicable)
+	}
+	if err != nil {
+		span.RecordError(err)
+		return domain.Redemption{}, err
+	}
+
+	userUses, err := s.repo.CountRedemptions(ctx, coupon.ID, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Redemption{}, err
+	}
+
+	if coupon.Restricted() {
+		if err := s.categorize(ctx, lines); err != nil {
+			span.RecordError(err)
+			return domain.Redemption{}, err
+		}
+	}
+
+	discounts, err := coupon.Discounts(lines, time.Now().UTC(), userUses)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Redemption{}, err
+	}
+
+	red := domain.Redemption{CouponID: coupon.ID, Code: coupon.Code, OrderID: orderID, UserID: userID}
+	for _, d := range discounts {
+		red.DiscountCents += d
+	}
+
+	saved, err := s.repo.SaveRedemption(ctx, red)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Redemption{}, err
+	}
+	saved.LineDiscounts = discounts
+
+	return saved, nil
+}
+
+// categorize fills in the product category of each line.
+func (s *redeemService) categorize(ctx context.Context, lines []domain.Line) error {
+	for i := range lines {
+		if lines[i].Category != "" {
+			continue
+		}
+		p, err := s.products.GetByID(ctx, lines[i].ProductID)
+		if err != nil {
+			return err
+		}
+		lines[i].Category = p.Category
+	}
+	return nil
+}
+
+func (s *redeemService) Release(ctx context.Context, orderID string) error {
+	ctx, span := s.tracer.StartSpan(ctx, "PromotionCommand.Release")
+	defer span.End()
+
+	if err := s.repo.DeleteRedemption(ctx, orderID); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
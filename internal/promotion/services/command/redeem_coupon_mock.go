@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotion/services/command/redeem_coupon.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/promotion/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRedeemService is a mock of RedeemService interface.
+type MockRedeemService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedeemServiceMockRecorder
+}
+
+// MockRedeemServiceMockRecorder is the mock recorder for MockRedeemService.
+type MockRedeemServiceMockRecorder struct {
+	mock *MockRedeemService
+}
+
+// NewMockRedeemService creates a new mock instance.
+func NewMockRedeemService(ctrl *gomock.Controller) *MockRedeemService {
+	mock := &MockRedeemService{ctrl: ctrl}
+	mock.recorder = &MockRedeemServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedeemService) EXPECT() *MockRedeemServiceMockRecorder {
+	return m.recorder
+}
+
+// Redeem mocks base method.
+func (m *MockRedeemService) Redeem(ctx context.Context, code, userID, orderID string, lines []domain.Line) (domain.Redemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, code, userID, orderID, lines)
+	ret0, _ := ret[0].(domain.Redemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockRedeemServiceMockRecorder) Redeem(ctx, code, userID, orderID, lines interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockRedeemService)(nil).Redeem), ctx, code, userID, orderID, lines)
+}
+
+// Release mocks base method.
+func (m *MockRedeemService) Release(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockRedeemServiceMockRecorder) Release(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockRedeemService)(nil).Release), ctx, orderID)
+}
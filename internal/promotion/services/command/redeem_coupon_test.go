@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+
+	productdomain "r2-challenge/internal/product/domain"
+	productqry "r2-challenge/internal/product/services/query"
+	repo "r2-challenge/internal/promotion/adapters/db"
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func newRedeemTest(t *testing.T) (RedeemService, *repo.MockPromotionRepository, *productqry.MockGetByIDService) {
+	t.Helper()
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockPromotionRepository(ctrl)
+	products := productqry.NewMockGetByIDService(ctrl)
+	s, _ := NewRedeemService(r, products, tracer)
+	return s, r, products
+}
+
+func saveRedemption(_ context.Context, red domain.Redemption) (domain.Redemption, error) {
+	red.ID = "red_1"
+	return red, nil
+}
+
+func TestRedeem_PercentageOnRestrictedCategory(t *testing.T) {
+	s, r, products := newRedeemTest(t)
+
+	r.EXPECT().LockCouponByCode(gomock.Any(), "BOOKS10").Return(domain.Coupon{
+		ID: "c1", Code: "BOOKS10", Type: domain.TypePercentage, PercentOff: 10, Categories: []string{"books"}, Active: true,
+	}, nil)
+	r.EXPECT().CountRedemptions(gomock.Any(), "c1", "u1").Return(int64(0), nil)
+	products.EXPECT().GetByID(gomock.Any(), "p_book").Return(productdomain.Product{Category: "Books"}, nil)
+	products.EXPECT().GetByID(gomock.Any(), "p_toy").Return(productdomain.Product{Category: "toys"}, nil)
+	r.EXPECT().SaveRedemption(gomock.Any(), gomock.Any()).DoAndReturn(saveRedemption)
+
+	red, err := s.Redeem(context.Background(), " books10 ", "u1", "o1", []domain.Line{
+		{ProductID: "p_book", Quantity: 2, UnitPriceCents: 1500},
+		{ProductID: "p_toy", Quantity: 1, UnitPriceCents: 4000},
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if red.DiscountCents != 300 || red.LineDiscounts[0] != 300 || red.LineDiscounts[1] != 0 || red.Code != "BOOKS10" {
+		t.Fatalf("unexpected redemption: %+v", red)
+	}
+}
+
+func TestRedeem_FixedAmountIsSpreadExactly(t *testing.T) {
+	s, r, _ := newRedeemTest(t)
+
+	r.EXPECT().LockCouponByCode(gomock.Any(), "TENOFF").Return(domain.Coupon{ID: "c1", Code: "TENOFF", Type: domain.TypeFixed, AmountOffCents: 1000, MinSpendCents: 2000, Active: true}, nil)
+	r.EXPECT().CountRedemptions(gomock.Any(), "c1", "u1").Return(int64(0), nil)
+	r.EXPECT().SaveRedemption(gomock.Any(), gomock.Any()).DoAndReturn(saveRedemption)
+
+	red, err := s.Redeem(context.Background(), "TENOFF", "u1", "o1", []domain.Line{
+		{ProductID: "a", Quantity: 1, UnitPriceCents: 1000},
+		{ProductID: "b", Quantity: 1, UnitPriceCents: 1000},
+		{ProductID: "c", Quantity: 1, UnitPriceCents: 1000},
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	var sum int64
+	for _, d := range red.LineDiscounts {
+		sum += d
+	}
+	if red.DiscountCents != 1000 || sum != 1000 {
+		t.Fatalf("expected 1000 spread over lines, got %+v", red)
+	}
+}
+
+func TestRedeem_FreeItem(t *testing.T) {
+	s, r, _ := newRedeemTest(t)
+
+	r.EXPECT().LockCouponByCode(gomock.Any(), "FREEMUG").Return(domain.Coupon{ID: "c1", Code: "FREEMUG", Type: domain.TypeFreeItem, FreeProductID: "mug", FreeQuantity: 1, Active: true}, nil)
+	r.EXPECT().CountRedemptions(gomock.Any(), "c1", "u1").Return(int64(0), nil)
+	r.EXPECT().SaveRedemption(gomock.Any(), gomock.Any()).DoAndReturn(saveRedemption)
+
+	red, err := s.Redeem(context.Background(), "FREEMUG", "u1", "o1", []domain.Line{
+		{ProductID: "tee", Quantity: 1, UnitPriceCents: 2000},
+		{ProductID: "mug", Quantity: 3, UnitPriceCents: 800},
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if red.DiscountCents != 800 || red.LineDiscounts[1] != 800 {
+		t.Fatalf("expected one free mug, got %+v", red)
+	}
+}
+
+func TestRedeem_NotApplicable(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	cases := map[string]domain.Coupon{
+		"inactive":     {ID: "c1", Type: domain.TypePercentage, PercentOff: 10},
+		"expired":      {ID: "c1", Type: domain.TypePercentage, PercentOff: 10, Active: true, EndsAt: &past},
+		"global limit": {ID: "c1", Type: domain.TypePercentage, PercentOff: 10, Active: true, MaxUses: 5, Uses: 5},
+		"user limit":   {ID: "c1", Type: domain.TypePercentage, PercentOff: 10, Active: true, MaxUsesPerUser: 1},
+		"min spend":    {ID: "c1", Type: domain.TypeFixed, AmountOffCents: 500, Active: true, MinSpendCents: 5000},
+		"no free item": {ID: "c1", Type: domain.TypeFreeItem, FreeProductID: "mug", FreeQuantity: 1, Active: true},
+	}
+
+	for name, coupon := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, r, _ := newRedeemTest(t)
+			r.EXPECT().LockCouponByCode(gomock.Any(), "CODE").Return(coupon, nil)
+			r.EXPECT().CountRedemptions(gomock.Any(), "c1", "u1").Return(int64(1), nil)
+
+			_, err := s.Redeem(context.Background(), "code", "u1", "o1", []domain.Line{{ProductID: "tee", Quantity: 1, UnitPriceCents: 2000}})
+			if !errors.Is(err, domain.ErrCouponNotApplicable) {
+				t.Fatalf("expected ErrCouponNotApplicable, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRedeem_UnknownCode(t *testing.T) {
+	s, r, _ := newRedeemTest(t)
+	r.EXPECT().LockCouponByCode(gomock.Any(), "NOPE").Return(domain.Coupon{}, gorm.ErrRecordNotFound)
+
+	if _, err := s.Redeem(context.Background(), "nope", "u1", "o1", nil); !errors.Is(err, domain.ErrCouponNotApplicable) {
+		t.Fatalf("expected ErrCouponNotApplicable, got %v", err)
+	}
+}
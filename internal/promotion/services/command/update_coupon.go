@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/promotion/adapters/db"
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateCouponService interface {
+	// Update replaces the coupon definition. The code cannot change, and
+	// redemptions already made keep counting against the limits.
+	Update(ctx context.Context, c domain.Coupon) (domain.Coupon, error)
+}
+
+type updateCouponService struct {
+	repo   repo.PromotionRepository
+	tracer observability.Tracer
+}
+
+func NewUpdateCouponService(r repo.PromotionRepository, t observability.Tracer) (UpdateCouponService, error) {
+	return &updateCouponService{repo: r, tracer: t}, nil
+}
+
+func (s *updateCouponService) Update(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PromotionCommand.UpdateCoupon")
+	defer span.End()
+
+	current, err := s.repo.GetCoupon(ctx, c.ID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	c.Code = current.Code
+	if err := c.Validate(); err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	updated, err := s.repo.UpdateCoupon(ctx, c)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotion/services/command/update_coupon.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/promotion/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateCouponService is a mock of UpdateCouponService interface.
+type MockUpdateCouponService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateCouponServiceMockRecorder
+}
+
+// MockUpdateCouponServiceMockRecorder is the mock recorder for MockUpdateCouponService.
+type MockUpdateCouponServiceMockRecorder struct {
+	mock *MockUpdateCouponService
+}
+
+// NewMockUpdateCouponService creates a new mock instance.
+func NewMockUpdateCouponService(ctrl *gomock.Controller) *MockUpdateCouponService {
+	mock := &MockUpdateCouponService{ctrl: ctrl}
+	mock.recorder = &MockUpdateCouponServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateCouponService) EXPECT() *MockUpdateCouponServiceMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateCouponService) Update(ctx context.Context, c domain.Coupon) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, c)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateCouponServiceMockRecorder) Update(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateCouponService)(nil).Update), ctx, c)
+}
@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/promotion/adapters/db"
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetCouponService interface {
+	GetByID(ctx context.Context, id string) (domain.Coupon, error)
+}
+
+type getCouponService struct {
+	repo   repo.PromotionRepository
+	tracer observability.Tracer
+}
+
+func NewGetCouponService(r repo.PromotionRepository, t observability.Tracer) (GetCouponService, error) {
+	return &getCouponService{repo: r, tracer: t}, nil
+}
+
+func (s *getCouponService) GetByID(ctx context.Context, id string) (domain.Coupon, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PromotionQuery.GetCoupon")
+	defer span.End()
+
+	c, err := s.repo.GetCoupon(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Coupon{}, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotion/services/query/get_coupon.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/promotion/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetCouponService is a mock of GetCouponService interface.
+type MockGetCouponService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCouponServiceMockRecorder
+}
+
+// MockGetCouponServiceMockRecorder is the mock recorder for MockGetCouponService.
+type MockGetCouponServiceMockRecorder struct {
+	mock *MockGetCouponService
+}
+
+// NewMockGetCouponService creates a new mock instance.
+func NewMockGetCouponService(ctrl *gomock.Controller) *MockGetCouponService {
+	mock := &MockGetCouponService{ctrl: ctrl}
+	mock.recorder = &MockGetCouponServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCouponService) EXPECT() *MockGetCouponServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockGetCouponService) GetByID(ctx context.Context, id string) (domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetCouponServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetCouponService)(nil).GetByID), ctx, id)
+}
@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/promotion/adapters/db"
+	"r2-challenge/internal/promotion/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListCouponsService interface {
+	// List returns coupons, newest first.
+	List(ctx context.Context, limit int, offset int) ([]domain.Coupon, error)
+}
+
+type listCouponsService struct {
+	repo   repo.PromotionRepository
+	tracer observability.Tracer
+}
+
+func NewListCouponsService(r repo.PromotionRepository, t observability.Tracer) (ListCouponsService, error) {
+	return &listCouponsService{repo: r, tracer: t}, nil
+}
+
+func (s *listCouponsService) List(ctx context.Context, limit int, offset int) ([]domain.Coupon, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "PromotionQuery.ListCoupons")
+	defer span.End()
+
+	list, err := s.repo.ListCoupons(ctx, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/promotion/services/query/list_coupons.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/promotion/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListCouponsService is a mock of ListCouponsService interface.
+type MockListCouponsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListCouponsServiceMockRecorder
+}
+
+// MockListCouponsServiceMockRecorder is the mock recorder for MockListCouponsService.
+type MockListCouponsServiceMockRecorder struct {
+	mock *MockListCouponsService
+}
+
+// NewMockListCouponsService creates a new mock instance.
+func NewMockListCouponsService(ctrl *gomock.Controller) *MockListCouponsService {
+	mock := &MockListCouponsService{ctrl: ctrl}
+	mock.recorder = &MockListCouponsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListCouponsService) EXPECT() *MockListCouponsServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListCouponsService) List(ctx context.Context, limit, offset int) ([]domain.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]domain.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListCouponsServiceMockRecorder) List(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListCouponsService)(nil).List), ctx, limit, offset)
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/reservation/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbReservationRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (ReservationRepository, error) {
+	return &dbReservationRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbReservationRepository) Reserve(ctx context.Context, res domain.Reservation) (domain.Reservation, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReservationRepository.Reserve")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if res.ID == "" {
+		res.ID = uuid.NewString()
+	}
+	res.Status = domain.StatusActive
+	res.CreatedAt = now
+	res.UpdatedAt = now
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// lock the product row so placements and other holds serialize on it
+		var product struct{ Inventory int64 }
+		lookup := tx.Raw("SELECT inventory FROM products WHERE id = ? FOR UPDATE", res.ProductID).Scan(&product)
+		if lookup.Error != nil {
+			return lookup.Error
+		}
+		if lookup.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var reserved int64
+		if err := tx.Raw("SELECT COALESCE(SUM(quantity), 0) FROM reservations WHERE product_id = ? AND status = ? AND expires_at > ?",
+			res.ProductID, domain.StatusActive, now).Scan(&reserved).Error; err != nil {
+			return err
+		}
+		if product.Inventory-reserved < res.Quantity {
+			return domain.ErrInsufficientStock
+		}
+
+		return tx.Table("reservations").Create(&res).Error
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Reservation{}, err
+	}
+
+	return res, nil
+}
+
+func (r *dbReservationRepository) Release(ctx context.Context, id string, userID string) (domain.Reservation, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReservationRepository.Release")
+	defer span.End()
+
+	var released []domain.Reservation
+	tx := appdb.Conn(ctx, r.db).Raw(
+		"UPDATE reservations SET status = ?, updated_at = ? WHERE id = ? AND user_id = ? AND status = ? RETURNING *",
+		domain.StatusReleased, time.Now().UTC(), id, userID, domain.StatusActive,
+	).Scan(&released)
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return domain.Reservation{}, tx.Error
+	}
+	if len(released) == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return domain.Reservation{}, gorm.ErrRecordNotFound
+	}
+
+	return released[0], nil
+}
+
+func (r *dbReservationRepository) ListActiveByUser(ctx context.Context, userID string) ([]domain.Reservation, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReservationRepository.ListActiveByUser")
+	defer span.End()
+
+	var list []domain.Reservation
+	if err := appdb.Conn(ctx, r.db).Table("reservations").
+		Where("user_id = ? AND status = ? AND expires_at > ?", userID, domain.StatusActive, time.Now().UTC()).
+		Order("expires_at asc").
+		Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *dbReservationRepository) ReleaseExpired(ctx context.Context, now time.Time) (int64, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReservationRepository.ReleaseExpired")
+	defer span.End()
+
+	tx := appdb.Conn(ctx, r.db).Table("reservations").
+		Where("status = ? AND expires_at <= ?", domain.StatusActive, now).
+		Updates(map[string]any{"status": domain.StatusReleased, "updated_at": now})
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return 0, tx.Error
+	}
+
+	return tx.RowsAffected, nil
+}
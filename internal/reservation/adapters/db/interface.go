@@ -0,0 +1,18 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"r2-challenge/internal/reservation/domain"
+)
+
+type ReservationRepository interface {
+	// Reserve stores the reservation if the product still has enough
+	// unreserved stock, failing with domain.ErrInsufficientStock otherwise.
+	Reserve(ctx context.Context, r domain.Reservation) (domain.Reservation, error)
+	Release(ctx context.Context, id string, userID string) (domain.Reservation, error)
+	ListActiveByUser(ctx context.Context, userID string) ([]domain.Reservation, error)
+	// ReleaseExpired releases active reservations that expired before now.
+	ReleaseExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reservation/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/reservation/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReservationRepository is a mock of ReservationRepository interface.
+type MockReservationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationRepositoryMockRecorder
+}
+
+// MockReservationRepositoryMockRecorder is the mock recorder for MockReservationRepository.
+type MockReservationRepositoryMockRecorder struct {
+	mock *MockReservationRepository
+}
+
+// NewMockReservationRepository creates a new mock instance.
+func NewMockReservationRepository(ctrl *gomock.Controller) *MockReservationRepository {
+	mock := &MockReservationRepository{ctrl: ctrl}
+	mock.recorder = &MockReservationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationRepository) EXPECT() *MockReservationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListActiveByUser mocks base method.
+func (m *MockReservationRepository) ListActiveByUser(ctx context.Context, userID string) ([]domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByUser", ctx, userID)
+	ret0, _ := ret[0].([]domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByUser indicates an expected call of ListActiveByUser.
+func (mr *MockReservationRepositoryMockRecorder) ListActiveByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByUser", reflect.TypeOf((*MockReservationRepository)(nil).ListActiveByUser), ctx, userID)
+}
+
+// Release mocks base method.
+func (m *MockReservationRepository) Release(ctx context.Context, id, userID string) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id, userID)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReservationRepositoryMockRecorder) Release(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReservationRepository)(nil).Release), ctx, id, userID)
+}
+
+// ReleaseExpired mocks base method.
+func (m *MockReservationRepository) ReleaseExpired(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpired", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpired indicates an expected call of ReleaseExpired.
+func (mr *MockReservationRepositoryMockRecorder) ReleaseExpired(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpired", reflect.TypeOf((*MockReservationRepository)(nil).ReleaseExpired), ctx, now)
+}
+
+// Reserve mocks base method.
+func (m *MockReservationRepository) Reserve(ctx context.Context, r domain.Reservation) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, r)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockReservationRepositoryMockRecorder) Reserve(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockReservationRepository)(nil).Reserve), ctx, r)
+}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/reservation/domain"
+	"r2-challenge/internal/reservation/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateReservationHandler struct {
+	service   command.ReserveService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCreateReservationHandler(s command.ReserveService, v *validator.Validate, t observability.Tracer) (CreateReservationHandler, error) {
+	return CreateReservationHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type createReservationRequest struct {
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	Quantity  int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+// Create Reservation
+// @Summary      Reserve stock
+// @Description  Hold product stock for the authenticated user for a limited time
+// @Tags         Reservations
+// @Accept       json
+// @Produce      json
+// @Param        reservation  body     createReservationRequest  true  "Reservation input"
+// @Success      201          {object} domain.Reservation
+// @Failure      400          {object} map[string]string "Bad Request"
+// @Failure      401          {object} map[string]string "Unauthorized"
+// @Failure      404          {object} map[string]string "Product Not Found"
+// @Failure      409          {object} map[string]string "Insufficient stock"
+// @Failure      500          {object} map[string]string "Internal Server Error"
+// @Router       /reservations [post]
+func (h CreateReservationHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReservationHTTP.Create")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	var req createReservationRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	reservation, err := h.service.Reserve(ctx, userID, req.ProductID, req.Quantity)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "product not found"})
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, reservation)
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/reservation/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type ListReservationsHandler struct {
+	service   query.ListByUserService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListReservationsHandler(s query.ListByUserService, v *validator.Validate, t observability.Tracer) (ListReservationsHandler, error) {
+	return ListReservationsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List My Reservations
+// @Summary      List my reservations
+// @Description  List the authenticated user's active reservations
+// @Tags         Reservations
+// @Produce      json
+// @Success      200  {array}  domain.Reservation
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /reservations [get]
+func (h ListReservationsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReservationHTTP.ListByUser")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	list, err := h.service.ListByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
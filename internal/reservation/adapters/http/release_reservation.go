@@ -0,0 +1,64 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/reservation/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type ReleaseReservationHandler struct {
+	service   command.ReleaseService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewReleaseReservationHandler(s command.ReleaseService, v *validator.Validate, t observability.Tracer) (ReleaseReservationHandler, error) {
+	return ReleaseReservationHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Release Reservation
+// @Summary      Release reservation
+// @Description  Give back the stock held by one of the authenticated user's active reservations
+// @Tags         Reservations
+// @Produce      json
+// @Param        id   path     string  true  "Reservation ID"
+// @Success      200  {object} domain.Reservation
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /reservations/{id} [delete]
+func (h ReleaseReservationHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReservationHTTP.Release")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	released, err := h.service.Release(ctx, id, userID)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, released)
+}
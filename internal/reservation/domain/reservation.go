@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Reservation lifecycle statuses.
+const (
+	StatusActive    = "active"
+	StatusConverted = "converted"
+	StatusReleased  = "released"
+)
+
+// ErrInsufficientStock is returned when the product has not enough unreserved stock.
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// Reservation holds product stock for a user until ExpiresAt. Active holds are
+// subtracted from a product's available stock and become part of the order
+// when the user places one for the same product.
+type Reservation struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id" validate:"required"`
+	ProductID string    `json:"product_id" validate:"required"`
+	Quantity  int64     `json:"quantity" validate:"required,gt=0"`
+	Status    string    `json:"status"`
+	OrderID   *string   `json:"order_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Held sums the quantity the reservations hold on each product.
+func Held(reservations []Reservation) map[string]int64 {
+	held := make(map[string]int64, len(reservations))
+	for _, r := range reservations {
+		held[r.ProductID] += r.Quantity
+	}
+	return held
+}
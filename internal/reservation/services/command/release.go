@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/reservation/adapters/db"
+	"r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ReleaseService interface {
+	// Release gives back the stock held by one of the user's active reservations.
+	Release(ctx context.Context, id string, userID string) (domain.Reservation, error)
+}
+
+type releaseService struct {
+	repo   repo.ReservationRepository
+	tracer observability.Tracer
+}
+
+func NewReleaseService(r repo.ReservationRepository, t observability.Tracer) (ReleaseService, error) {
+	return &releaseService{repo: r, tracer: t}, nil
+}
+
+func (s *releaseService) Release(ctx context.Context, id string, userID string) (domain.Reservation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReservationCommand.Release")
+	defer span.End()
+
+	released, err := s.repo.Release(ctx, id, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Reservation{}, err
+	}
+
+	return released, nil
+}
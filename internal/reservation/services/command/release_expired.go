@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	repo "r2-challenge/internal/reservation/adapters/db"
+	"r2-challenge/pkg/observability"
+)
+
+type ReleaseExpiredService interface {
+	// ReleaseExpired marks every expired active reservation as released and
+	// returns how many were released.
+	ReleaseExpired(ctx context.Context) (int64, error)
+}
+
+type releaseExpiredService struct {
+	repo   repo.ReservationRepository
+	tracer observability.Tracer
+}
+
+func NewReleaseExpiredService(r repo.ReservationRepository, t observability.Tracer) (ReleaseExpiredService, error) {
+	return &releaseExpiredService{repo: r, tracer: t}, nil
+}
+
+func (s *releaseExpiredService) ReleaseExpired(ctx context.Context) (int64, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReservationCommand.ReleaseExpired")
+	defer span.End()
+
+	released, err := s.repo.ReleaseExpired(ctx, time.Now().UTC())
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	return released, nil
+}
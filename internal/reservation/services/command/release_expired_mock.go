@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reservation/services/command/release_expired.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReleaseExpiredService is a mock of ReleaseExpiredService interface.
+type MockReleaseExpiredService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReleaseExpiredServiceMockRecorder
+}
+
+// MockReleaseExpiredServiceMockRecorder is the mock recorder for MockReleaseExpiredService.
+type MockReleaseExpiredServiceMockRecorder struct {
+	mock *MockReleaseExpiredService
+}
+
+// NewMockReleaseExpiredService creates a new mock instance.
+func NewMockReleaseExpiredService(ctrl *gomock.Controller) *MockReleaseExpiredService {
+	mock := &MockReleaseExpiredService{ctrl: ctrl}
+	mock.recorder = &MockReleaseExpiredServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReleaseExpiredService) EXPECT() *MockReleaseExpiredServiceMockRecorder {
+	return m.recorder
+}
+
+// ReleaseExpired mocks base method.
+func (m *MockReleaseExpiredService) ReleaseExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpired indicates an expected call of ReleaseExpired.
+func (mr *MockReleaseExpiredServiceMockRecorder) ReleaseExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpired", reflect.TypeOf((*MockReleaseExpiredService)(nil).ReleaseExpired), ctx)
+}
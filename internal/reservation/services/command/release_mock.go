@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reservation/services/command/release.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/reservation/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReleaseService is a mock of ReleaseService interface.
+type MockReleaseService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReleaseServiceMockRecorder
+}
+
+// MockReleaseServiceMockRecorder is the mock recorder for MockReleaseService.
+type MockReleaseServiceMockRecorder struct {
+	mock *MockReleaseService
+}
+
+// NewMockReleaseService creates a new mock instance.
+func NewMockReleaseService(ctrl *gomock.Controller) *MockReleaseService {
+	mock := &MockReleaseService{ctrl: ctrl}
+	mock.recorder = &MockReleaseServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReleaseService) EXPECT() *MockReleaseServiceMockRecorder {
+	return m.recorder
+}
+
+// Release mocks base method.
+func (m *MockReleaseService) Release(ctx context.Context, id, userID string) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id, userID)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReleaseServiceMockRecorder) Release(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReleaseService)(nil).Release), ctx, id, userID)
+}
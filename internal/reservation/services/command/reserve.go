@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"r2-challenge/cmd/envs"
+	repo "r2-challenge/internal/reservation/adapters/db"
+	"r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+const defaultReservationTTL = 15 * time.Minute
+
+type ReserveService interface {
+	// Reserve holds quantity units of the product for the user for the configured TTL.
+	Reserve(ctx context.Context, userID string, productID string, quantity int64) (domain.Reservation, error)
+}
+
+type reserveService struct {
+	repo   repo.ReservationRepository
+	ttl    time.Duration
+	tracer observability.Tracer
+}
+
+func NewReserveService(r repo.ReservationRepository, e envs.Envs, t observability.Tracer) (ReserveService, error) {
+	ttl, err := time.ParseDuration(e.ReservationTTL)
+	if err != nil || ttl <= 0 {
+		ttl = defaultReservationTTL
+	}
+	return &reserveService{repo: r, ttl: ttl, tracer: t}, nil
+}
+
+func (s *reserveService) Reserve(ctx context.Context, userID string, productID string, quantity int64) (domain.Reservation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReservationCommand.Reserve")
+	defer span.End()
+
+	saved, err := s.repo.Reserve(ctx, domain.Reservation{
+		UserID:    userID,
+		ProductID: productID,
+		Quantity:  quantity,
+		ExpiresAt: time.Now().UTC().Add(s.ttl),
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Reservation{}, err
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/reservation/services/command/reserve.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/reservation/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReserveService is a mock of ReserveService interface.
+type MockReserveService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReserveServiceMockRecorder
+}
+
+// MockReserveServiceMockRecorder is the mock recorder for MockReserveService.
+type MockReserveServiceMockRecorder struct {
+	mock *MockReserveService
+}
+
+// NewMockReserveService creates a new mock instance.
+func NewMockReserveService(ctrl *gomock.Controller) *MockReserveService {
+	mock := &MockReserveService{ctrl: ctrl}
+	mock.recorder = &MockReserveServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReserveService) EXPECT() *MockReserveServiceMockRecorder {
+	return m.recorder
+}
+
+// Reserve mocks base method.
+func (m *MockReserveService) Reserve(ctx context.Context, userID, productID string, quantity int64) (domain.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, userID, productID, quantity)
+	ret0, _ := ret[0].(domain.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockReserveServiceMockRecorder) Reserve(ctx, userID, productID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockReserveService)(nil).Reserve), ctx, userID, productID, quantity)
+}
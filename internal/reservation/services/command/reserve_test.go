@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	"r2-challenge/cmd/envs"
+	rsvdb "r2-challenge/internal/reservation/adapters/db"
+	"r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestReserve_AppliesConfiguredTTL(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := rsvdb.NewMockReservationRepository(ctrl)
+	s, _ := NewReserveService(repo, envs.Envs{ReservationTTL: "10m"}, tracer)
+
+	before := time.Now().UTC()
+	repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r domain.Reservation) (domain.Reservation, error) {
+		if r.UserID != "u1" || r.ProductID != "p1" || r.Quantity != 2 {
+			t.Fatalf("unexpected reservation: %+v", r)
+		}
+		if ttl := r.ExpiresAt.Sub(before); ttl < 10*time.Minute || ttl > 11*time.Minute {
+			t.Fatalf("unexpected ttl: %v", ttl)
+		}
+		r.ID = "r1"
+		return r, nil
+	})
+
+	res, err := s.Reserve(context.Background(), "u1", "p1", 2)
+	if err != nil {
+		t.Fatalf("Reserve failed: %v", err)
+	}
+	if res.ID != "r1" {
+		t.Fatalf("unexpected id: %s", res.ID)
+	}
+}
+
+func TestReserve_PropagatesInsufficientStock(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := rsvdb.NewMockReservationRepository(ctrl)
+	s, _ := NewReserveService(repo, envs.Envs{}, tracer)
+
+	repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(domain.Reservation{}, domain.ErrInsufficientStock)
+
+	if _, err := s.Reserve(context.Background(), "u1", "p1", 2); !errors.Is(err, domain.ErrInsufficientStock) {
+		t.Fatalf("expected ErrInsufficientStock, got %v", err)
+	}
+}
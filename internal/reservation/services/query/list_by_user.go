@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/reservation/adapters/db"
+	"r2-challenge/internal/reservation/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListByUserService interface {
+	ListByUser(ctx context.Context, userID string) ([]domain.Reservation, error)
+}
+
+type service struct {
+	repo   repo.ReservationRepository
+	tracer observability.Tracer
+}
+
+func NewListByUserService(r repo.ReservationRepository, t observability.Tracer) (ListByUserService, error) {
+	return &service{repo: r, tracer: t}, nil
+}
+
+func (s *service) ListByUser(ctx context.Context, userID string) ([]domain.Reservation, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReservationQuery.ListByUser")
+	defer span.End()
+
+	list, err := s.repo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
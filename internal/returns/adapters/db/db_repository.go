@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/returns/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbReturnRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (ReturnRepository, error) {
+	return &dbReturnRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbReturnRepository) LockOrder(ctx context.Context, orderID string) error {
+	ctx, span := r.tracer.StartSpan(ctx, "ReturnRepository.LockOrder")
+	defer span.End()
+
+	var ids []string
+	if err := appdb.Conn(ctx, r.db).Raw("SELECT id FROM orders WHERE id = ? FOR UPDATE", orderID).Scan(&ids).Error; err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if len(ids) == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *dbReturnRepository) Save(ctx context.Context, ret domain.Return) (domain.Return, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReturnRepository.Save")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if ret.ID == "" {
+		ret.ID = uuid.NewString()
+	}
+	ret.Status = domain.StatusRequested
+	ret.Restocked = false
+	ret.CreatedAt = now
+	ret.UpdatedAt = now
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("returns").Create(&ret).Error; err != nil {
+			return err
+		}
+		for i := range ret.Items {
+			it := &ret.Items[i]
+			it.ID = uuid.NewString()
+			it.ReturnID = ret.ID
+			it.CreatedAt = now
+			if err := tx.Table("return_items").Create(it).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+	return ret, nil
+}
+
+func (r *dbReturnRepository) Get(ctx context.Context, id string) (domain.Return, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReturnRepository.Get")
+	defer span.End()
+
+	var ret domain.Return
+	if err := appdb.Conn(ctx, r.db).Table("returns").Where("id = ?", id).First(&ret).Error; err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+	ret.Items = []domain.ReturnItem{}
+	if err := appdb.Conn(ctx, r.db).Table("return_items").Where("return_id = ?", id).Order("created_at").Find(&ret.Items).Error; err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+	return ret, nil
+}
+
+func (r *dbReturnRepository) List(ctx context.Context, filter ReturnFilter) ([]domain.Return, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReturnRepository.List")
+	defer span.End()
+
+	query := appdb.Conn(ctx, r.db).Table("returns")
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.OrderID != "" {
+		query = query.Where("order_id = ?", filter.OrderID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	returns := []domain.Return{}
+	if err := query.Order("created_at DESC").Find(&returns).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(returns) == 0 {
+		return returns, nil
+	}
+
+	ids := make([]string, 0, len(returns))
+	for _, ret := range returns {
+		ids = append(ids, ret.ID)
+	}
+	var items []domain.ReturnItem
+	if err := appdb.Conn(ctx, r.db).Table("return_items").Where("return_id IN ?", ids).Order("created_at").Find(&items).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	itemsByReturn := make(map[string][]domain.ReturnItem, len(returns))
+	for _, it := range items {
+		itemsByReturn[it.ReturnID] = append(itemsByReturn[it.ReturnID], it)
+	}
+	for i := range returns {
+		returns[i].Items = itemsByReturn[returns[i].ID]
+	}
+	return returns, nil
+}
+
+func (r *dbReturnRepository) UpdateStatus(ctx context.Context, id string, from string, to string, note string) (domain.Return, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReturnRepository.UpdateStatus")
+	defer span.End()
+
+	res := appdb.Conn(ctx, r.db).Table("returns").Where("id = ? AND status = ?", id, from).Updates(map[string]any{
+		"status":     to,
+		"note":       note,
+		"updated_at": time.Now().UTC(),
+	})
+	if res.Error != nil {
+		span.RecordError(res.Error)
+		return domain.Return{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		err := conflictOrMissing(appdb.Conn(ctx, r.db), id)
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return r.Get(ctx, id)
+}
+
+func (r *dbReturnRepository) Receive(ctx context.Context, id string, restock bool) (domain.Return, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "ReturnRepository.Receive")
+	defer span.End()
+
+	now := time.Now().UTC()
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Table("returns").Where("id = ? AND status = ?", id, domain.StatusApproved).Updates(map[string]any{
+			"status":     domain.StatusReceived,
+			"restocked":  restock,
+			"updated_at": now,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return conflictOrMissing(tx, id)
+		}
+		if !restock {
+			return nil
+		}
+
+		return tx.Exec(`UPDATE products p SET inventory = p.inventory + back.quantity, updated_at = ?
+			FROM (SELECT oi.product_id, SUM(ri.quantity) AS quantity
+				FROM return_items ri JOIN order_items oi ON oi.id = ri.order_item_id
+				WHERE ri.return_id = ? GROUP BY oi.product_id) back
+			WHERE p.id = back.product_id`, now, id).Error
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return r.Get(ctx, id)
+}
+
+// conflictOrMissing explains why a status change matched no row.
+func conflictOrMissing(tx *gorm.DB, id string) error {
+	var count int64
+	if err := tx.Table("returns").Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return domain.ErrStatusConflict
+}
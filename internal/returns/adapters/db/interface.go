@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/returns/domain"
+)
+
+// ReturnFilter narrows List; empty fields match every return.
+type ReturnFilter struct {
+	UserID  string
+	OrderID string
+	Status  string
+	Limit   int
+	Offset  int
+}
+
+type ReturnRepository interface {
+	// LockOrder holds the order row until the surrounding transaction ends,
+	// so the returns of one order are requested one at a time.
+	LockOrder(ctx context.Context, orderID string) error
+	Save(ctx context.Context, r domain.Return) (domain.Return, error)
+	Get(ctx context.Context, id string) (domain.Return, error)
+	// List returns returns with their items, newest first.
+	List(ctx context.Context, filter ReturnFilter) ([]domain.Return, error)
+	// UpdateStatus moves the return from status `from` to `to` and stores
+	// note, failing with domain.ErrStatusConflict when the current status is
+	// not `from`.
+	UpdateStatus(ctx context.Context, id string, from string, to string, note string) (domain.Return, error)
+	// Receive moves an approved return to received and, when restock is
+	// set, gives its quantities back to the products' inventory. It fails
+	// with domain.ErrStatusConflict when the return is not approved.
+	Receive(ctx context.Context, id string, restock bool) (domain.Return, error)
+}
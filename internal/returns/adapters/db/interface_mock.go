@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReturnRepository is a mock of ReturnRepository interface.
+type MockReturnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnRepositoryMockRecorder
+}
+
+// MockReturnRepositoryMockRecorder is the mock recorder for MockReturnRepository.
+type MockReturnRepositoryMockRecorder struct {
+	mock *MockReturnRepository
+}
+
+// NewMockReturnRepository creates a new mock instance.
+func NewMockReturnRepository(ctrl *gomock.Controller) *MockReturnRepository {
+	mock := &MockReturnRepository{ctrl: ctrl}
+	mock.recorder = &MockReturnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturnRepository) EXPECT() *MockReturnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockReturnRepository) Get(ctx context.Context, id string) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReturnRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReturnRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReturnRepository) List(ctx context.Context, filter ReturnFilter) ([]domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReturnRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReturnRepository)(nil).List), ctx, filter)
+}
+
+// LockOrder mocks base method.
+func (m *MockReturnRepository) LockOrder(ctx context.Context, orderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOrder", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockOrder indicates an expected call of LockOrder.
+func (mr *MockReturnRepositoryMockRecorder) LockOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOrder", reflect.TypeOf((*MockReturnRepository)(nil).LockOrder), ctx, orderID)
+}
+
+// Receive mocks base method.
+func (m *MockReturnRepository) Receive(ctx context.Context, id string, restock bool) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, id, restock)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockReturnRepositoryMockRecorder) Receive(ctx, id, restock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockReturnRepository)(nil).Receive), ctx, id, restock)
+}
+
+// Save mocks base method.
+func (m *MockReturnRepository) Save(ctx context.Context, r domain.Return) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, r)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReturnRepositoryMockRecorder) Save(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReturnRepository)(nil).Save), ctx, r)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockReturnRepository) UpdateStatus(ctx context.Context, id, from, to, note string) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to, note)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockReturnRepositoryMockRecorder) UpdateStatus(ctx, id, from, to, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockReturnRepository)(nil).UpdateStatus), ctx, id, from, to, note)
+}
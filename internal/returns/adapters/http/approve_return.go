@@ -0,0 +1,83 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	orderdomain "r2-challenge/internal/order/domain"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/internal/returns/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type ApproveReturnHandler struct {
+	service   command.ApproveReturnService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewApproveReturnHandler(s command.ApproveReturnService, v *validator.Validate, t observability.Tracer) (ApproveReturnHandler, error) {
+	return ApproveReturnHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// reviewReturnRequest is the body of approvals and rejections.
+type reviewReturnRequest struct {
+	Note string `json:"note" validate:"max=500"`
+}
+
+// Approve Return
+// @Summary      Approve return
+// @Description  Accept a requested return and refund its refund_cents to the customer
+// @Tags         Returns
+// @Accept       json
+// @Produce      json
+// @Param        id    path     string               true   "Return ID"
+// @Param        body  body     reviewReturnRequest  false  "Note for the customer"
+// @Success      200   {object} domain.Return
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /returns/{id}/approve [post]
+func (h ApproveReturnHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReturnHTTP.Approve")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req reviewReturnRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	ret, err := h.service.Approve(ctx, id, req.Note)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrStatusConflict), errors.Is(err, orderdomain.ErrStatusConflict),
+			errors.Is(err, pmtdomain.ErrNothingToRefund), errors.Is(err, pmtdomain.ErrRefundExceedsCaptured):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, ret)
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/returns/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type GetReturnHandler struct {
+	service   query.GetReturnService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetReturnHandler(s query.GetReturnService, v *validator.Validate, t observability.Tracer) (GetReturnHandler, error) {
+	return GetReturnHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Return
+// @Summary      Get return
+// @Description  A return with its items (owner or admin)
+// @Tags         Returns
+// @Produce      json
+// @Param        id   path     string  true  "Return ID"
+// @Success      200  {object} domain.Return
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Router       /returns/{id} [get]
+func (h GetReturnHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReturnHTTP.Get")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ret, err := h.service.Get(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+
+	role, _ := c.Get(auth.CtxRole).(string)
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if role != "admin" && ret.UserID != userID {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+	}
+
+	return c.JSON(http.StatusOK, ret)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	repo "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type ListReturnsHandler struct {
+	service   query.ListReturnsService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListReturnsHandler(s query.ListReturnsService, v *validator.Validate, t observability.Tracer) (ListReturnsHandler, error) {
+	return ListReturnsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Returns
+// @Summary      List returns
+// @Description  Returns, newest first. Admins see every return and may filter by user; other users see their own.
+// @Tags         Returns
+// @Produce      json
+// @Param        status    query    string  false  "requested|approved|rejected|received"
+// @Param        order_id  query    string  false  "Order ID"
+// @Param        user_id   query    string  false  "User ID (admin only)"
+// @Param        limit     query    int     false  "Limit"
+// @Param        offset    query    int     false  "Offset"
+// @Success      200       {array}  domain.Return
+// @Failure      401       {object} map[string]string "Unauthorized"
+// @Failure      500       {object} map[string]string "Internal Server Error"
+// @Router       /returns [get]
+func (h ListReturnsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReturnHTTP.List")
+	defer span.End()
+
+	filter := repo.ReturnFilter{
+		OrderID: c.QueryParam("order_id"),
+		Status:  c.QueryParam("status"),
+		Limit:   50,
+	}
+	if s := c.QueryParam("limit"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			filter.Limit = v
+		}
+	}
+	if s := c.QueryParam("offset"); s != "" {
+		if v, err := strconv.Atoi(s); err == nil {
+			filter.Offset = v
+		}
+	}
+
+	role, _ := c.Get(auth.CtxRole).(string)
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if role == "admin" {
+		filter.UserID = c.QueryParam("user_id")
+	} else {
+		filter.UserID = userID
+	}
+
+	list, err := h.service.List(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/internal/returns/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type ReceiveReturnHandler struct {
+	service   command.ReceiveReturnService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewReceiveReturnHandler(s command.ReceiveReturnService, v *validator.Validate, t observability.Tracer) (ReceiveReturnHandler, error) {
+	return ReceiveReturnHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type receiveReturnRequest struct {
+	// puts the returned quantities back into inventory
+	Restock bool `json:"restock"`
+}
+
+// Receive Return
+// @Summary      Receive return
+// @Description  Record that the items of an approved return arrived, optionally restocking them
+// @Tags         Returns
+// @Accept       json
+// @Produce      json
+// @Param        id    path     string                true   "Return ID"
+// @Param        body  body     receiveReturnRequest  false  "Receive input"
+// @Success      200   {object} domain.Return
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /returns/{id}/receive [post]
+func (h ReceiveReturnHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReturnHTTP.Receive")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req receiveReturnRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+
+	ret, err := h.service.Receive(ctx, id, req.Restock)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, ret)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/internal/returns/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type RejectReturnHandler struct {
+	service   command.RejectReturnService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewRejectReturnHandler(s command.RejectReturnService, v *validator.Validate, t observability.Tracer) (RejectReturnHandler, error) {
+	return RejectReturnHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Reject Return
+// @Summary      Reject return
+// @Description  Refuse a requested return; its items can be requested again
+// @Tags         Returns
+// @Accept       json
+// @Produce      json
+// @Param        id    path     string               true   "Return ID"
+// @Param        body  body     reviewReturnRequest  false  "Reason given to the customer"
+// @Success      200   {object} domain.Return
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /returns/{id}/reject [post]
+func (h RejectReturnHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReturnHTTP.Reject")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req reviewReturnRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	ret, err := h.service.Reject(ctx, id, req.Note)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, domain.ErrStatusConflict):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, ret)
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	orderdomain "r2-challenge/internal/order/domain"
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/internal/returns/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type RequestReturnHandler struct {
+	service   command.RequestReturnService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewRequestReturnHandler(s command.RequestReturnService, v *validator.Validate, t observability.Tracer) (RequestReturnHandler, error) {
+	return RequestReturnHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type requestReturnRequest struct {
+	Reason string              `json:"reason" validate:"required,max=500"`
+	Items  []returnItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type returnItemRequest struct {
+	OrderItemID string `json:"order_item_id" validate:"required,uuid"`
+	Quantity    int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+// Request Return
+// @Summary      Request return
+// @Description  Ask to send items of a delivered order back (order owner only)
+// @Tags         Returns
+// @Accept       json
+// @Produce      json
+// @Param        id    path     string                true  "Order ID"
+// @Param        body  body     requestReturnRequest  true  "Return input"
+// @Success      201   {object} domain.Return
+// @Failure      400   {object} map[string]string "Bad Request"
+// @Failure      401   {object} map[string]string "Unauthorized"
+// @Failure      403   {object} map[string]string "Forbidden"
+// @Failure      404   {object} map[string]string "Not Found"
+// @Failure      409   {object} map[string]string "Conflict"
+// @Failure      422   {object} map[string]string "Unprocessable Entity"
+// @Failure      500   {object} map[string]string "Internal Server Error"
+// @Router       /orders/{id}/returns [post]
+func (h RequestReturnHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "ReturnHTTP.Request")
+	defer span.End()
+
+	orderID := c.Param("id")
+	if err := h.validator.Var(orderID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req requestReturnRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	ret := domain.Return{OrderID: orderID, Reason: req.Reason}
+	for _, it := range req.Items {
+		ret.Items = append(ret.Items, domain.ReturnItem{OrderItemID: it.OrderItemID, Quantity: it.Quantity})
+	}
+
+	saved, err := h.service.Request(ctx, userID, ret)
+	if err != nil {
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		case errors.Is(err, orderdomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "forbidden"})
+		case errors.Is(err, domain.ErrNotReturnable):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		case errors.Is(err, domain.ErrInvalidReturn):
+			return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, saved)
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	orderdomain "r2-challenge/internal/order/domain"
+)
+
+// Return statuses.
+const (
+	StatusRequested = "requested"
+	StatusApproved  = "approved"
+	StatusRejected  = "rejected"
+	// the items are back in the warehouse
+	StatusReceived = "received"
+)
+
+// Outbox topics published by the returns module; all carry the Return.
+const (
+	TopicReturnRequested = "return.requested"
+	TopicReturnApproved  = "return.approved"
+	TopicReturnRejected  = "return.rejected"
+	TopicReturnReceived  = "return.received"
+)
+
+var (
+	// ErrInvalidReturn is returned when a return does not match what is left
+	// to return on its order; the wrapped message says why.
+	ErrInvalidReturn = errors.New("invalid return")
+	// ErrNotReturnable is returned when the order was not delivered.
+	ErrNotReturnable = errors.New("order cannot be returned")
+	// ErrStatusConflict is returned when the return is not in the status the
+	// operation expects, e.g. approving a rejected return.
+	ErrStatusConflict = errors.New("return status does not allow this operation")
+)
+
+// Return is a customer's request to send items of a delivered order back.
+// RefundCents is what the customer gets back once the return is approved:
+// the share of each item's total, tax included, for the returned quantity.
+// Shipping is not refunded.
+type Return struct {
+	ID          string       `json:"id" gorm:"primaryKey;type:uuid"`
+	OrderID     string       `json:"order_id" gorm:"type:uuid"`
+	UserID      string       `json:"user_id" gorm:"type:uuid"`
+	Status      string       `json:"status"`
+	Reason      string       `json:"reason"`
+	Note        string       `json:"note,omitempty"`
+	RefundCents int64        `json:"refund_cents"`
+	Restocked   bool         `json:"restocked"`
+	Items       []ReturnItem `json:"items" gorm:"-"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// ReturnItem is the quantity of one order item sent back.
+type ReturnItem struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid"`
+	ReturnID    string    `json:"return_id" gorm:"type:uuid"`
+	OrderItemID string    `json:"order_item_id" gorm:"type:uuid"`
+	Quantity    int64     `json:"quantity"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Returnable returns the quantity of each order item, by item id, that is
+// in none of the returns; rejected returns give their items back. Items
+// returned in full are left out.
+func Returnable(order orderdomain.Order, returns []Return) map[string]int64 {
+	left := make(map[string]int64, len(order.Items))
+	for _, it := range order.Items {
+		left[it.ID] += it.Quantity
+	}
+	for _, r := range returns {
+		if r.Status == StatusRejected {
+			continue
+		}
+		for _, it := range r.Items {
+			left[it.OrderItemID] -= it.Quantity
+		}
+	}
+	for id, n := range left {
+		if n <= 0 {
+			delete(left, id)
+		}
+	}
+	return left
+}
+
+// Validate checks that r only lists items of a delivered order, in
+// quantities the earlier returns left, and sets its refund.
+func (r *Return) Validate(order orderdomain.Order, returns []Return) error {
+	if order.Status != orderdomain.StatusDelivered {
+		return fmt.Errorf("%w: order is %s", ErrNotReturnable, order.Status)
+	}
+	if len(r.Items) == 0 {
+		return fmt.Errorf("%w: no items", ErrInvalidReturn)
+	}
+
+	left := Returnable(order, returns)
+	byID := make(map[string]orderdomain.OrderItem, len(order.Items))
+	for _, it := range order.Items {
+		byID[it.ID] = it
+	}
+	r.RefundCents = 0
+	for _, it := range r.Items {
+		ordered, ok := byID[it.OrderItemID]
+		switch {
+		case !ok:
+			return fmt.Errorf("%w: item %s is not part of the order", ErrInvalidReturn, it.OrderItemID)
+		case it.Quantity <= 0:
+			return fmt.Errorf("%w: quantity of item %s must be positive", ErrInvalidReturn, it.OrderItemID)
+		case it.Quantity > left[it.OrderItemID]:
+			return fmt.Errorf("%w: only %d of item %s left to return", ErrInvalidReturn, left[it.OrderItemID], it.OrderItemID)
+		}
+		// priced on the units returned so far, so returning an item in
+		// several parts refunds exactly its total
+		before := ordered.Quantity - left[it.OrderItemID]
+		left[it.OrderItemID] -= it.Quantity
+		r.RefundCents += share(ordered, before+it.Quantity) - share(ordered, before)
+	}
+	return nil
+}
+
+// share is the part of the item's total paid for n of its units.
+func share(it orderdomain.OrderItem, n int64) int64 {
+	return it.TotalCents * n / it.Quantity
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	ordercmd "r2-challenge/internal/order/services/command"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	returndb "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type ApproveReturnService interface {
+	// Approve accepts a requested return and refunds its RefundCents against
+	// the order's captured payments, in one transaction: a failed refund
+	// leaves the return requested.
+	Approve(ctx context.Context, id string, note string) (domain.Return, error)
+}
+
+type approveReturnService struct {
+	repo    returndb.ReturnRepository
+	refunds ordercmd.RefundOrderService
+	events  outboxcmd.PublishService
+	tx      appdb.Transactor
+	tracer  observability.Tracer
+}
+
+func NewApproveReturnService(r returndb.ReturnRepository, rs ordercmd.RefundOrderService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (ApproveReturnService, error) {
+	return &approveReturnService{repo: r, refunds: rs, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *approveReturnService) Approve(ctx context.Context, id string, note string) (domain.Return, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReturnCommand.Approve")
+	defer span.End()
+
+	var approved domain.Return
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		approved, err = s.repo.UpdateStatus(ctx, id, domain.StatusRequested, domain.StatusApproved, note)
+		if err != nil {
+			return err
+		}
+
+		// a zero amount would refund the whole order
+		if approved.RefundCents > 0 {
+			reason := fmt.Sprintf("return %s: %s", approved.ID, approved.Reason)
+			if _, err := s.refunds.Refund(ctx, approved.OrderID, approved.RefundCents, reason); err != nil {
+				return err
+			}
+		}
+		return s.events.Publish(ctx, domain.TopicReturnApproved, approved)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return approved, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/services/command/approve_return.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockApproveReturnService is a mock of ApproveReturnService interface.
+type MockApproveReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApproveReturnServiceMockRecorder
+}
+
+// MockApproveReturnServiceMockRecorder is the mock recorder for MockApproveReturnService.
+type MockApproveReturnServiceMockRecorder struct {
+	mock *MockApproveReturnService
+}
+
+// NewMockApproveReturnService creates a new mock instance.
+func NewMockApproveReturnService(ctrl *gomock.Controller) *MockApproveReturnService {
+	mock := &MockApproveReturnService{ctrl: ctrl}
+	mock.recorder = &MockApproveReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApproveReturnService) EXPECT() *MockApproveReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockApproveReturnService) Approve(ctx context.Context, id, note string) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, note)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockApproveReturnServiceMockRecorder) Approve(ctx, id, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockApproveReturnService)(nil).Approve), ctx, id, note)
+}
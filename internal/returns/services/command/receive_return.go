@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	returndb "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type ReceiveReturnService interface {
+	// Receive records that the items of an approved return arrived; restock
+	// puts them back into inventory.
+	Receive(ctx context.Context, id string, restock bool) (domain.Return, error)
+}
+
+type receiveReturnService struct {
+	repo   returndb.ReturnRepository
+	events outboxcmd.PublishService
+	tx     appdb.Transactor
+	tracer observability.Tracer
+}
+
+func NewReceiveReturnService(r returndb.ReturnRepository, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (ReceiveReturnService, error) {
+	return &receiveReturnService{repo: r, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *receiveReturnService) Receive(ctx context.Context, id string, restock bool) (domain.Return, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReturnCommand.Receive")
+	defer span.End()
+
+	var received domain.Return
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		received, err = s.repo.Receive(ctx, id, restock)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TopicReturnReceived, received)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return received, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/services/command/receive_return.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiveReturnService is a mock of ReceiveReturnService interface.
+type MockReceiveReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiveReturnServiceMockRecorder
+}
+
+// MockReceiveReturnServiceMockRecorder is the mock recorder for MockReceiveReturnService.
+type MockReceiveReturnServiceMockRecorder struct {
+	mock *MockReceiveReturnService
+}
+
+// NewMockReceiveReturnService creates a new mock instance.
+func NewMockReceiveReturnService(ctrl *gomock.Controller) *MockReceiveReturnService {
+	mock := &MockReceiveReturnService{ctrl: ctrl}
+	mock.recorder = &MockReceiveReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiveReturnService) EXPECT() *MockReceiveReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Receive mocks base method.
+func (m *MockReceiveReturnService) Receive(ctx context.Context, id string, restock bool) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, id, restock)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockReceiveReturnServiceMockRecorder) Receive(ctx, id, restock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockReceiveReturnService)(nil).Receive), ctx, id, restock)
+}
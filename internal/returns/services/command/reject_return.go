@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	returndb "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type RejectReturnService interface {
+	// Reject refuses a requested return; its items may be returned again.
+	Reject(ctx context.Context, id string, note string) (domain.Return, error)
+}
+
+type rejectReturnService struct {
+	repo   returndb.ReturnRepository
+	events outboxcmd.PublishService
+	tx     appdb.Transactor
+	tracer observability.Tracer
+}
+
+func NewRejectReturnService(r returndb.ReturnRepository, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (RejectReturnService, error) {
+	return &rejectReturnService{repo: r, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *rejectReturnService) Reject(ctx context.Context, id string, note string) (domain.Return, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReturnCommand.Reject")
+	defer span.End()
+
+	var rejected domain.Return
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		rejected, err = s.repo.UpdateStatus(ctx, id, domain.StatusRequested, domain.StatusRejected, note)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TopicReturnRejected, rejected)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return rejected, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/services/command/reject_return.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRejectReturnService is a mock of RejectReturnService interface.
+type MockRejectReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRejectReturnServiceMockRecorder
+}
+
+// MockRejectReturnServiceMockRecorder is the mock recorder for MockRejectReturnService.
+type MockRejectReturnServiceMockRecorder struct {
+	mock *MockRejectReturnService
+}
+
+// NewMockRejectReturnService creates a new mock instance.
+func NewMockRejectReturnService(ctrl *gomock.Controller) *MockRejectReturnService {
+	mock := &MockRejectReturnService{ctrl: ctrl}
+	mock.recorder = &MockRejectReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRejectReturnService) EXPECT() *MockRejectReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Reject mocks base method.
+func (m *MockRejectReturnService) Reject(ctx context.Context, id, note string) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, note)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockRejectReturnServiceMockRecorder) Reject(ctx, id, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockRejectReturnService)(nil).Reject), ctx, id, note)
+}
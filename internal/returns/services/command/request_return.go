@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+
+	orderdomain "r2-challenge/internal/order/domain"
+	orderqry "r2-challenge/internal/order/services/query"
+	outboxcmd "r2-challenge/internal/outbox/services/command"
+	returndb "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type RequestReturnService interface {
+	// Request opens a return of items of one of the user's delivered orders.
+	Request(ctx context.Context, userID string, ret domain.Return) (domain.Return, error)
+}
+
+type requestReturnService struct {
+	repo   returndb.ReturnRepository
+	orders orderqry.GetByIDService
+	events outboxcmd.PublishService
+	tx     appdb.Transactor
+	tracer observability.Tracer
+}
+
+func NewRequestReturnService(r returndb.ReturnRepository, o orderqry.GetByIDService, ev outboxcmd.PublishService, tx appdb.Transactor, t observability.Tracer) (RequestReturnService, error) {
+	return &requestReturnService{repo: r, orders: o, events: ev, tx: tx, tracer: t}, nil
+}
+
+func (s *requestReturnService) Request(ctx context.Context, userID string, ret domain.Return) (domain.Return, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReturnCommand.Request")
+	defer span.End()
+
+	var saved domain.Return
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.LockOrder(ctx, ret.OrderID); err != nil {
+			return err
+		}
+		order, err := s.orders.GetByID(ctx, ret.OrderID)
+		if err != nil {
+			return err
+		}
+		if order.UserID != userID {
+			return orderdomain.ErrForbidden
+		}
+		earlier, err := s.repo.List(ctx, returndb.ReturnFilter{OrderID: order.ID})
+		if err != nil {
+			return err
+		}
+		if err := ret.Validate(order, earlier); err != nil {
+			return err
+		}
+
+		ret.ID = ""
+		ret.UserID = userID
+		ret.Note = ""
+		saved, err = s.repo.Save(ctx, ret)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TopicReturnRequested, saved)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/services/command/request_return.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRequestReturnService is a mock of RequestReturnService interface.
+type MockRequestReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestReturnServiceMockRecorder
+}
+
+// MockRequestReturnServiceMockRecorder is the mock recorder for MockRequestReturnService.
+type MockRequestReturnServiceMockRecorder struct {
+	mock *MockRequestReturnService
+}
+
+// NewMockRequestReturnService creates a new mock instance.
+func NewMockRequestReturnService(ctrl *gomock.Controller) *MockRequestReturnService {
+	mock := &MockRequestReturnService{ctrl: ctrl}
+	mock.recorder = &MockRequestReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestReturnService) EXPECT() *MockRequestReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Request mocks base method.
+func (m *MockRequestReturnService) Request(ctx context.Context, userID string, ret domain.Return) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret_2 := m.ctrl.Call(m, "Request", ctx, userID, ret)
+	ret0, _ := ret_2[0].(domain.Return)
+	ret1, _ := ret_2[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockRequestReturnServiceMockRecorder) Request(ctx, userID, ret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockRequestReturnService)(nil).Request), ctx, userID, ret)
+}
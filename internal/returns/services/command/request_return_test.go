@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	orderdomain "r2-challenge/internal/order/domain"
+	ordercmd "r2-challenge/internal/order/services/command"
+	orderqry "r2-challenge/internal/order/services/query"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	returndb "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/pkg/observability"
+)
+
+// stubTx runs the unit of work inline, standing in for a database transaction.
+type stubTx struct{}
+
+func (stubTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// stubPublisher accepts every outbox event.
+type stubPublisher struct{}
+
+func (stubPublisher) Publish(context.Context, string, any) error { return nil }
+
+func deliveredOrder() orderdomain.Order {
+	return orderdomain.Order{ID: "o1", UserID: "u1", Status: orderdomain.StatusDelivered, Items: []orderdomain.OrderItem{
+		{ID: "i1", ProductID: "p1", Quantity: 3, TotalCents: 1000},
+		{ID: "i2", ProductID: "p2", Quantity: 1, TotalCents: 550},
+	}}
+}
+
+func TestRequestReturn_RefundsShareOfItemTotals(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := returndb.NewMockReturnRepository(ctrl)
+	orders := orderqry.NewMockGetByIDService(ctrl)
+	s, _ := NewRequestReturnService(repo, orders, stubPublisher{}, stubTx{}, tracer)
+
+	repo.EXPECT().LockOrder(gomock.Any(), "o1").Return(nil)
+	orders.EXPECT().GetByID(gomock.Any(), "o1").Return(deliveredOrder(), nil)
+	// one unit of i1 is already being returned; a rejected return does not count
+	repo.EXPECT().List(gomock.Any(), returndb.ReturnFilter{OrderID: "o1"}).Return([]domain.Return{
+		{ID: "r0", Status: domain.StatusApproved, Items: []domain.ReturnItem{{OrderItemID: "i1", Quantity: 1}}},
+		{ID: "rx", Status: domain.StatusRejected, Items: []domain.ReturnItem{{OrderItemID: "i2", Quantity: 1}}},
+	}, nil)
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r domain.Return) (domain.Return, error) {
+		r.ID = "r1"
+		r.Status = domain.StatusRequested
+		return r, nil
+	})
+
+	ret, err := s.Request(context.Background(), "u1", domain.Return{OrderID: "o1", Reason: "damaged", Items: []domain.ReturnItem{
+		{OrderItemID: "i1", Quantity: 2},
+		{OrderItemID: "i2", Quantity: 1},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	// the last two thirds of 1000 are 667 (1000 - 333), plus all of i2
+	if ret.UserID != "u1" || ret.RefundCents != 667+550 {
+		t.Fatalf("unexpected return: %+v", ret)
+	}
+}
+
+func TestRequestReturn_Rejections(t *testing.T) {
+	undelivered := deliveredOrder()
+	undelivered.Status = orderdomain.StatusShipped
+
+	cases := []struct {
+		name  string
+		user  string
+		order orderdomain.Order
+		items []domain.ReturnItem
+		want  error
+	}{
+		{"not the owner", "u2", deliveredOrder(), []domain.ReturnItem{{OrderItemID: "i1", Quantity: 1}}, orderdomain.ErrForbidden},
+		{"not delivered", "u1", undelivered, []domain.ReturnItem{{OrderItemID: "i1", Quantity: 1}}, domain.ErrNotReturnable},
+		{"unknown item", "u1", deliveredOrder(), []domain.ReturnItem{{OrderItemID: "i9", Quantity: 1}}, domain.ErrInvalidReturn},
+		{"too many", "u1", deliveredOrder(), []domain.ReturnItem{{OrderItemID: "i1", Quantity: 2}, {OrderItemID: "i1", Quantity: 2}}, domain.ErrInvalidReturn},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracer, _ := observability.SetupTracer()
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			repo := returndb.NewMockReturnRepository(ctrl)
+			orders := orderqry.NewMockGetByIDService(ctrl)
+			s, _ := NewRequestReturnService(repo, orders, stubPublisher{}, stubTx{}, tracer)
+
+			repo.EXPECT().LockOrder(gomock.Any(), "o1").Return(nil)
+			orders.EXPECT().GetByID(gomock.Any(), "o1").Return(tc.order, nil)
+			repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			_, err := s.Request(context.Background(), tc.user, domain.Return{OrderID: "o1", Reason: "r", Items: tc.items})
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestApproveReturn_RefundsThroughOrder(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := returndb.NewMockReturnRepository(ctrl)
+	refunds := ordercmd.NewMockRefundOrderService(ctrl)
+	s, _ := NewApproveReturnService(repo, refunds, stubPublisher{}, stubTx{}, tracer)
+
+	approved := domain.Return{ID: "r1", OrderID: "o1", Status: domain.StatusApproved, Reason: "damaged", RefundCents: 1217}
+	repo.EXPECT().UpdateStatus(gomock.Any(), "r1", domain.StatusRequested, domain.StatusApproved, "ok").Return(approved, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(1217), "return r1: damaged").Return(pmtdomain.RefundResult{}, nil)
+
+	if _, err := s.Approve(context.Background(), "r1", "ok"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	// a refund failure is returned so the transaction rolls the approval back
+	repo.EXPECT().UpdateStatus(gomock.Any(), "r1", domain.StatusRequested, domain.StatusApproved, "").Return(approved, nil)
+	refunds.EXPECT().Refund(gomock.Any(), "o1", int64(1217), gomock.Any()).Return(pmtdomain.RefundResult{}, pmtdomain.ErrRefundExceedsCaptured)
+	if _, err := s.Approve(context.Background(), "r1", ""); !errors.Is(err, pmtdomain.ErrRefundExceedsCaptured) {
+		t.Fatalf("expected ErrRefundExceedsCaptured, got %v", err)
+	}
+}
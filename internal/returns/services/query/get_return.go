@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetReturnService interface {
+	Get(ctx context.Context, id string) (domain.Return, error)
+}
+
+type getReturnService struct {
+	repo   repo.ReturnRepository
+	tracer observability.Tracer
+}
+
+func NewGetReturnService(r repo.ReturnRepository, t observability.Tracer) (GetReturnService, error) {
+	return &getReturnService{repo: r, tracer: t}, nil
+}
+
+func (s *getReturnService) Get(ctx context.Context, id string) (domain.Return, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReturnQuery.Get")
+	defer span.End()
+
+	ret, err := s.repo.Get(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Return{}, err
+	}
+
+	return ret, nil
+}
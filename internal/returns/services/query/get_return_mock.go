@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/services/query/get_return.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetReturnService is a mock of GetReturnService interface.
+type MockGetReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetReturnServiceMockRecorder
+}
+
+// MockGetReturnServiceMockRecorder is the mock recorder for MockGetReturnService.
+type MockGetReturnServiceMockRecorder struct {
+	mock *MockGetReturnService
+}
+
+// NewMockGetReturnService creates a new mock instance.
+func NewMockGetReturnService(ctrl *gomock.Controller) *MockGetReturnService {
+	mock := &MockGetReturnService{ctrl: ctrl}
+	mock.recorder = &MockGetReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetReturnService) EXPECT() *MockGetReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGetReturnService) Get(ctx context.Context, id string) (domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGetReturnServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetReturnService)(nil).Get), ctx, id)
+}
@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/returns/adapters/db"
+	"r2-challenge/internal/returns/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListReturnsService interface {
+	// List returns returns matching the filter, newest first.
+	List(ctx context.Context, filter repo.ReturnFilter) ([]domain.Return, error)
+}
+
+type listReturnsService struct {
+	repo   repo.ReturnRepository
+	tracer observability.Tracer
+}
+
+func NewListReturnsService(r repo.ReturnRepository, t observability.Tracer) (ListReturnsService, error) {
+	return &listReturnsService{repo: r, tracer: t}, nil
+}
+
+func (s *listReturnsService) List(ctx context.Context, filter repo.ReturnFilter) ([]domain.Return, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "ReturnQuery.List")
+	defer span.End()
+
+	list, err := s.repo.List(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
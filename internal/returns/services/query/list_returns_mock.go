@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/returns/services/query/list_returns.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	db "r2-challenge/internal/returns/adapters/db"
+	domain "r2-challenge/internal/returns/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListReturnsService is a mock of ListReturnsService interface.
+type MockListReturnsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListReturnsServiceMockRecorder
+}
+
+// MockListReturnsServiceMockRecorder is the mock recorder for MockListReturnsService.
+type MockListReturnsServiceMockRecorder struct {
+	mock *MockListReturnsService
+}
+
+// NewMockListReturnsService creates a new mock instance.
+func NewMockListReturnsService(ctrl *gomock.Controller) *MockListReturnsService {
+	mock := &MockListReturnsService{ctrl: ctrl}
+	mock.recorder = &MockListReturnsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListReturnsService) EXPECT() *MockListReturnsServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListReturnsService) List(ctx context.Context, filter db.ReturnFilter) ([]domain.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]domain.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListReturnsServiceMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListReturnsService)(nil).List), ctx, filter)
+}
@@ -0,0 +1,22 @@
+package db
+
+import (
+	"context"
+
+	"r2-challenge/internal/user/domain"
+)
+
+// AddressRepository stores address books. Every method is scoped to one
+// user, so an address of another user is reported as not found.
+type AddressRepository interface {
+	// Save adds an address; the first address of a user, or one saved with
+	// IsDefault, becomes the default.
+	Save(ctx context.Context, a domain.Address) (domain.Address, error)
+	Update(ctx context.Context, a domain.Address) (domain.Address, error)
+	Delete(ctx context.Context, userID string, id string) error
+	Get(ctx context.Context, userID string, id string) (domain.Address, error)
+	// GetDefault returns gorm.ErrRecordNotFound when the user has no default address.
+	GetDefault(ctx context.Context, userID string) (domain.Address, error)
+	// List returns the default address first, then the newest.
+	List(ctx context.Context, userID string) ([]domain.Address, error)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/user/adapters/db/address_interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/user/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddressRepository is a mock of AddressRepository interface.
+type MockAddressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressRepositoryMockRecorder
+}
+
+// MockAddressRepositoryMockRecorder is the mock recorder for MockAddressRepository.
+type MockAddressRepositoryMockRecorder struct {
+	mock *MockAddressRepository
+}
+
+// NewMockAddressRepository creates a new mock instance.
+func NewMockAddressRepository(ctrl *gomock.Controller) *MockAddressRepository {
+	mock := &MockAddressRepository{ctrl: ctrl}
+	mock.recorder = &MockAddressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddressRepository) EXPECT() *MockAddressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAddressRepository) Delete(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAddressRepositoryMockRecorder) Delete(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAddressRepository)(nil).Delete), ctx, userID, id)
+}
+
+// Get mocks base method.
+func (m *MockAddressRepository) Get(ctx context.Context, userID, id string) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, id)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAddressRepositoryMockRecorder) Get(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAddressRepository)(nil).Get), ctx, userID, id)
+}
+
+// GetDefault mocks base method.
+func (m *MockAddressRepository) GetDefault(ctx context.Context, userID string) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefault", ctx, userID)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefault indicates an expected call of GetDefault.
+func (mr *MockAddressRepositoryMockRecorder) GetDefault(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockAddressRepository)(nil).GetDefault), ctx, userID)
+}
+
+// List mocks base method.
+func (m *MockAddressRepository) List(ctx context.Context, userID string) ([]domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAddressRepositoryMockRecorder) List(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAddressRepository)(nil).List), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockAddressRepository) Save(ctx context.Context, a domain.Address) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, a)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAddressRepositoryMockRecorder) Save(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAddressRepository)(nil).Save), ctx, a)
+}
+
+// Update mocks base method.
+func (m *MockAddressRepository) Update(ctx context.Context, a domain.Address) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, a)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAddressRepositoryMockRecorder) Update(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAddressRepository)(nil).Update), ctx, a)
+}
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/user/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbAddressRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewAddressRepository(database *appdb.Database, t observability.Tracer) (AddressRepository, error) {
+	return &dbAddressRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbAddressRepository) Save(ctx context.Context, a domain.Address) (domain.Address, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "AddressRepository.Save")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if a.ID == "" {
+		a.ID = uuid.NewString()
+	}
+	a.CreatedAt = now
+	a.UpdatedAt = now
+
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if !a.IsDefault {
+			var count int64
+			if err := tx.Table("addresses").Where("user_id = ?", a.UserID).Count(&count).Error; err != nil {
+				return err
+			}
+			a.IsDefault = count == 0
+		}
+		if a.IsDefault {
+			if err := clearDefault(tx, a.UserID, now); err != nil {
+				return err
+			}
+		}
+		return tx.Table("addresses").Create(&a).Error
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return a, nil
+}
+
+func (r *dbAddressRepository) Update(ctx context.Context, a domain.Address) (domain.Address, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "AddressRepository.Update")
+	defer span.End()
+
+	now := time.Now().UTC()
+	err := appdb.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if a.IsDefault {
+			if err := clearDefault(tx, a.UserID, now); err != nil {
+				return err
+			}
+		}
+		updates := map[string]any{
+			"name":        a.Name,
+			"line1":       a.Line1,
+			"line2":       a.Line2,
+			"city":        a.City,
+			"region":      a.Region,
+			"postal_code": a.PostalCode,
+			"country":     a.Country,
+			"phone":       a.Phone,
+			"updated_at":  now,
+		}
+		// the default moves by making another address the default
+		if a.IsDefault {
+			updates["is_default"] = true
+		}
+		res := tx.Table("addresses").Where("id = ? AND user_id = ?", a.ID, a.UserID).Updates(updates)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return r.Get(ctx, a.UserID, a.ID)
+}
+
+func (r *dbAddressRepository) Delete(ctx context.Context, userID string, id string) error {
+	ctx, span := r.tracer.StartSpan(ctx, "AddressRepository.Delete")
+	defer span.End()
+
+	res := appdb.Conn(ctx, r.db).Table("addresses").Where("id = ? AND user_id = ?", id, userID).Delete(&domain.Address{})
+	if res.Error != nil {
+		span.RecordError(res.Error)
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *dbAddressRepository) Get(ctx context.Context, userID string, id string) (domain.Address, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "AddressRepository.Get")
+	defer span.End()
+
+	var a domain.Address
+	if err := appdb.Conn(ctx, r.db).Table("addresses").Where("id = ? AND user_id = ?", id, userID).First(&a).Error; err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return a, nil
+}
+
+func (r *dbAddressRepository) GetDefault(ctx context.Context, userID string) (domain.Address, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "AddressRepository.GetDefault")
+	defer span.End()
+
+	var a domain.Address
+	if err := appdb.Conn(ctx, r.db).Table("addresses").Where("user_id = ? AND is_default", userID).First(&a).Error; err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return a, nil
+}
+
+func (r *dbAddressRepository) List(ctx context.Context, userID string) ([]domain.Address, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "AddressRepository.List")
+	defer span.End()
+
+	list := []domain.Address{}
+	if err := appdb.Conn(ctx, r.db).Table("addresses").Where("user_id = ?", userID).
+		Order("is_default DESC").Order("created_at DESC").Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return list, nil
+}
+
+func clearDefault(tx *gorm.DB, userID string, now time.Time) error {
+	return tx.Table("addresses").Where("user_id = ? AND is_default", userID).
+		Updates(map[string]any{"is_default": false, "updated_at": now}).Error
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/user/domain"
+	"r2-challenge/internal/user/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateAddressHandler struct {
+	service   command.CreateAddressService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCreateAddressHandler(s command.CreateAddressService, v *validator.Validate, t observability.Tracer) (CreateAddressHandler, error) {
+	return CreateAddressHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type addressRequest struct {
+	Name       string `json:"name" validate:"required,max=200"`
+	Line1      string `json:"line1" validate:"required,max=200"`
+	Line2      string `json:"line2" validate:"max=200"`
+	City       string `json:"city" validate:"required,max=100"`
+	Region     string `json:"region" validate:"max=16"`
+	PostalCode string `json:"postal_code" validate:"required,max=20"`
+	Country    string `json:"country" validate:"required,len=2,alpha"`
+	Phone      string `json:"phone" validate:"max=32"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+func (r addressRequest) toDomain(userID string, id string) domain.Address {
+	return domain.Address{
+		ID: id, UserID: userID, Name: r.Name, Line1: r.Line1, Line2: r.Line2, City: r.City,
+		Region: r.Region, PostalCode: r.PostalCode, Country: r.Country, Phone: r.Phone, IsDefault: r.IsDefault,
+	}
+}
+
+// Create Address
+// @Summary      Add address
+// @Description  Add an address to the authenticated user's address book; the first address becomes the default
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        address  body     addressRequest  true  "Address input"
+// @Success      201      {object} domain.Address
+// @Failure      400      {object} map[string]string "Bad Request"
+// @Failure      401      {object} map[string]string "Unauthorized"
+// @Failure      500      {object} map[string]string "Internal Server Error"
+// @Router       /users/me/addresses [post]
+func (h CreateAddressHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "UserHTTP.CreateAddress")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	var req addressRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	saved, err := h.service.Create(ctx, req.toDomain(userID, ""))
+	if err != nil {
+		span.RecordError(err)
+		return writeAddressError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, saved)
+}
+
+func writeAddressError(c echo.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
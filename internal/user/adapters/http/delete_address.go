@@ -0,0 +1,58 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/user/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type DeleteAddressHandler struct {
+	service   command.DeleteAddressService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewDeleteAddressHandler(s command.DeleteAddressService, v *validator.Validate, t observability.Tracer) (DeleteAddressHandler, error) {
+	return DeleteAddressHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Delete Address
+// @Summary      Delete address
+// @Description  Remove an address from the authenticated user's address book
+// @Tags         Users
+// @Produce      json
+// @Param        id   path     string  true  "Address ID"
+// @Success      204  {string} string  "No Content"
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /users/me/addresses/{id} [delete]
+func (h DeleteAddressHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "UserHTTP.DeleteAddress")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	if err := h.service.Delete(ctx, userID, id); err != nil {
+		span.RecordError(err)
+		return writeAddressError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
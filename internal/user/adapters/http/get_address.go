@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/user/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type GetAddressHandler struct {
+	service   query.GetAddressService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetAddressHandler(s query.GetAddressService, v *validator.Validate, t observability.Tracer) (GetAddressHandler, error) {
+	return GetAddressHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Address
+// @Summary      Get address
+// @Description  Get an address of the authenticated user's address book
+// @Tags         Users
+// @Produce      json
+// @Param        id   path     string  true  "Address ID"
+// @Success      200  {object} domain.Address
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /users/me/addresses/{id} [get]
+func (h GetAddressHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "UserHTTP.GetAddress")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	address, err := h.service.Get(ctx, userID, id)
+	if err != nil {
+		span.RecordError(err)
+		return writeAddressError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, address)
+}
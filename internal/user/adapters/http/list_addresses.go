@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/user/services/query"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type ListAddressesHandler struct {
+	service   query.ListAddressesService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListAddressesHandler(s query.ListAddressesService, v *validator.Validate, t observability.Tracer) (ListAddressesHandler, error) {
+	return ListAddressesHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Addresses
+// @Summary      List my addresses
+// @Description  List the authenticated user's address book, default address first
+// @Tags         Users
+// @Produce      json
+// @Success      200  {array}  domain.Address
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /users/me/addresses [get]
+func (h ListAddressesHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "UserHTTP.ListAddresses")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	list, err := h.service.List(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/user/services/command"
+	"r2-challenge/pkg/auth"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateAddressHandler struct {
+	service   command.UpdateAddressService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewUpdateAddressHandler(s command.UpdateAddressService, v *validator.Validate, t observability.Tracer) (UpdateAddressHandler, error) {
+	return UpdateAddressHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Update Address
+// @Summary      Update address
+// @Description  Replace an address of the authenticated user's address book
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string          true  "Address ID"
+// @Param        address  body     addressRequest  true  "Address input"
+// @Success      200      {object} domain.Address
+// @Failure      400      {object} map[string]string "Bad Request"
+// @Failure      401      {object} map[string]string "Unauthorized"
+// @Failure      404      {object} map[string]string "Not Found"
+// @Failure      500      {object} map[string]string "Internal Server Error"
+// @Router       /users/me/addresses/{id} [put]
+func (h UpdateAddressHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "UserHTTP.UpdateAddress")
+	defer span.End()
+
+	userID, _ := c.Get(auth.CtxUserID).(string)
+	if err := h.validator.Var(userID, "required"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+	}
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req addressRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	updated, err := h.service.Update(ctx, req.toDomain(userID, id))
+	if err != nil {
+		span.RecordError(err)
+		return writeAddressError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, updated)
+}
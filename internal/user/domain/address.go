@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Address is an entry of a user's address book. Country is an ISO 3166-1
+// alpha-2 code. At most one address per user is the default.
+type Address struct {
+	ID         string    `json:"id" gorm:"primaryKey;type:uuid"`
+	UserID     string    `json:"user_id" gorm:"type:uuid"`
+	Name       string    `json:"name"`
+	Line1      string    `json:"line1"`
+	Line2      string    `json:"line2"`
+	City       string    `json:"city"`
+	Region     string    `json:"region"`
+	PostalCode string    `json:"postal_code"`
+	Country    string    `json:"country"`
+	Phone      string    `json:"phone"`
+	IsDefault  bool      `json:"is_default"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	userdb "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/internal/user/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateAddressService interface {
+	// Create adds an address to the user's address book.
+	Create(ctx context.Context, address domain.Address) (domain.Address, error)
+}
+
+type createAddressService struct {
+	repo   userdb.AddressRepository
+	tracer observability.Tracer
+}
+
+func NewCreateAddressService(r userdb.AddressRepository, t observability.Tracer) (CreateAddressService, error) {
+	return &createAddressService{repo: r, tracer: t}, nil
+}
+
+func (s *createAddressService) Create(ctx context.Context, address domain.Address) (domain.Address, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "UserCommand.CreateAddress")
+	defer span.End()
+
+	address.ID = ""
+	saved, err := s.repo.Save(ctx, normalizeAddress(address))
+	if err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return saved, nil
+}
+
+// normalizeAddress upper-cases the country and region codes so they match
+// the tax and shipping tables.
+func normalizeAddress(a domain.Address) domain.Address {
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	a.Region = strings.ToUpper(strings.TrimSpace(a.Region))
+	return a
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/user/services/command/create_address.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/user/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateAddressService is a mock of CreateAddressService interface.
+type MockCreateAddressService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateAddressServiceMockRecorder
+}
+
+// MockCreateAddressServiceMockRecorder is the mock recorder for MockCreateAddressService.
+type MockCreateAddressServiceMockRecorder struct {
+	mock *MockCreateAddressService
+}
+
+// NewMockCreateAddressService creates a new mock instance.
+func NewMockCreateAddressService(ctrl *gomock.Controller) *MockCreateAddressService {
+	mock := &MockCreateAddressService{ctrl: ctrl}
+	mock.recorder = &MockCreateAddressServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateAddressService) EXPECT() *MockCreateAddressServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateAddressService) Create(ctx context.Context, address domain.Address) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, address)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateAddressServiceMockRecorder) Create(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateAddressService)(nil).Create), ctx, address)
+}
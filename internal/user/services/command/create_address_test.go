@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	userdb "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/internal/user/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestCreateAddress_NormalizesCodes(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := userdb.NewMockAddressRepository(ctrl)
+	svc, _ := NewCreateAddressService(repo, tracer)
+
+	repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a domain.Address) (domain.Address, error) {
+		if a.ID != "" || a.Country != "US" || a.Region != "CA" {
+			t.Fatalf("unexpected address: %+v", a)
+		}
+		a.ID = "a1"
+		return a, nil
+	})
+
+	res, err := svc.Create(context.Background(), domain.Address{ID: "spoofed", UserID: "u1", Line1: "1 Main St", City: "LA", Region: " ca", Country: "us "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.ID != "a1" {
+		t.Fatalf("expected id, got %+v", res)
+	}
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+
+	userdb "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/pkg/observability"
+)
+
+type DeleteAddressService interface {
+	// Delete removes an address from the user's address book. Orders keep
+	// their own copy of the address they were shipped to.
+	Delete(ctx context.Context, userID string, id string) error
+}
+
+type deleteAddressService struct {
+	repo   userdb.AddressRepository
+	tracer observability.Tracer
+}
+
+func NewDeleteAddressService(r userdb.AddressRepository, t observability.Tracer) (DeleteAddressService, error) {
+	return &deleteAddressService{repo: r, tracer: t}, nil
+}
+
+func (s *deleteAddressService) Delete(ctx context.Context, userID string, id string) error {
+	ctx, span := s.tracer.StartSpan(ctx, "UserCommand.DeleteAddress")
+	defer span.End()
+
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/user/services/command/delete_address.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeleteAddressService is a mock of DeleteAddressService interface.
+type MockDeleteAddressService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteAddressServiceMockRecorder
+}
+
+// MockDeleteAddressServiceMockRecorder is the mock recorder for MockDeleteAddressService.
+type MockDeleteAddressServiceMockRecorder struct {
+	mock *MockDeleteAddressService
+}
+
+// NewMockDeleteAddressService creates a new mock instance.
+func NewMockDeleteAddressService(ctrl *gomock.Controller) *MockDeleteAddressService {
+	mock := &MockDeleteAddressService{ctrl: ctrl}
+	mock.recorder = &MockDeleteAddressServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteAddressService) EXPECT() *MockDeleteAddressServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeleteAddressService) Delete(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeleteAddressServiceMockRecorder) Delete(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteAddressService)(nil).Delete), ctx, userID, id)
+}
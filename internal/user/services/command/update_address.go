@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+
+	userdb "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/internal/user/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateAddressService interface {
+	// Update replaces an address of the user's address book; setting
+	// IsDefault makes it the default.
+	Update(ctx context.Context, address domain.Address) (domain.Address, error)
+}
+
+type updateAddressService struct {
+	repo   userdb.AddressRepository
+	tracer observability.Tracer
+}
+
+func NewUpdateAddressService(r userdb.AddressRepository, t observability.Tracer) (UpdateAddressService, error) {
+	return &updateAddressService{repo: r, tracer: t}, nil
+}
+
+func (s *updateAddressService) Update(ctx context.Context, address domain.Address) (domain.Address, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "UserCommand.UpdateAddress")
+	defer span.End()
+
+	updated, err := s.repo.Update(ctx, normalizeAddress(address))
+	if err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return updated, nil
+}
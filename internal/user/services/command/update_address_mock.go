@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/user/services/command/update_address.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/user/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateAddressService is a mock of UpdateAddressService interface.
+type MockUpdateAddressService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateAddressServiceMockRecorder
+}
+
+// MockUpdateAddressServiceMockRecorder is the mock recorder for MockUpdateAddressService.
+type MockUpdateAddressServiceMockRecorder struct {
+	mock *MockUpdateAddressService
+}
+
+// NewMockUpdateAddressService creates a new mock instance.
+func NewMockUpdateAddressService(ctrl *gomock.Controller) *MockUpdateAddressService {
+	mock := &MockUpdateAddressService{ctrl: ctrl}
+	mock.recorder = &MockUpdateAddressServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateAddressService) EXPECT() *MockUpdateAddressServiceMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateAddressService) Update(ctx context.Context, address domain.Address) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, address)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateAddressServiceMockRecorder) Update(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateAddressService)(nil).Update), ctx, address)
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/internal/user/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetAddressService interface {
+	// Get returns an address of the user; gorm.ErrRecordNotFound when the
+	// user has no such address.
+	Get(ctx context.Context, userID string, id string) (domain.Address, error)
+	// Default returns the user's default address; gorm.ErrRecordNotFound
+	// when the address book is empty.
+	Default(ctx context.Context, userID string) (domain.Address, error)
+}
+
+type getAddressService struct {
+	repo   repo.AddressRepository
+	tracer observability.Tracer
+}
+
+func NewGetAddressService(r repo.AddressRepository, t observability.Tracer) (GetAddressService, error) {
+	return &getAddressService{repo: r, tracer: t}, nil
+}
+
+func (s *getAddressService) Get(ctx context.Context, userID string, id string) (domain.Address, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "UserQuery.GetAddress")
+	defer span.End()
+
+	a, err := s.repo.Get(ctx, userID, id)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return a, nil
+}
+
+func (s *getAddressService) Default(ctx context.Context, userID string) (domain.Address, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "UserQuery.DefaultAddress")
+	defer span.End()
+
+	a, err := s.repo.GetDefault(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Address{}, err
+	}
+	return a, nil
+}
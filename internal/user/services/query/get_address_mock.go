@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/user/services/query/get_address.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/user/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetAddressService is a mock of GetAddressService interface.
+type MockGetAddressService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAddressServiceMockRecorder
+}
+
+// MockGetAddressServiceMockRecorder is the mock recorder for MockGetAddressService.
+type MockGetAddressServiceMockRecorder struct {
+	mock *MockGetAddressService
+}
+
+// NewMockGetAddressService creates a new mock instance.
+func NewMockGetAddressService(ctrl *gomock.Controller) *MockGetAddressService {
+	mock := &MockGetAddressService{ctrl: ctrl}
+	mock.recorder = &MockGetAddressServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAddressService) EXPECT() *MockGetAddressServiceMockRecorder {
+	return m.recorder
+}
+
+// Default mocks base method.
+func (m *MockGetAddressService) Default(ctx context.Context, userID string) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Default", ctx, userID)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Default indicates an expected call of Default.
+func (mr *MockGetAddressServiceMockRecorder) Default(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MockGetAddressService)(nil).Default), ctx, userID)
+}
+
+// Get mocks base method.
+func (m *MockGetAddressService) Get(ctx context.Context, userID, id string) (domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, id)
+	ret0, _ := ret[0].(domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGetAddressServiceMockRecorder) Get(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetAddressService)(nil).Get), ctx, userID, id)
+}
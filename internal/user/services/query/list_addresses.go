@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/user/adapters/db"
+	"r2-challenge/internal/user/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListAddressesService interface {
+	List(ctx context.Context, userID string) ([]domain.Address, error)
+}
+
+type listAddressesService struct {
+	repo   repo.AddressRepository
+	tracer observability.Tracer
+}
+
+func NewListAddressesService(r repo.AddressRepository, t observability.Tracer) (ListAddressesService, error) {
+	return &listAddressesService{repo: r, tracer: t}, nil
+}
+
+func (s *listAddressesService) List(ctx context.Context, userID string) ([]domain.Address, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "UserQuery.ListAddresses")
+	defer span.End()
+
+	list, err := s.repo.List(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/user/services/query/list_addresses.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/user/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListAddressesService is a mock of ListAddressesService interface.
+type MockListAddressesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListAddressesServiceMockRecorder
+}
+
+// MockListAddressesServiceMockRecorder is the mock recorder for MockListAddressesService.
+type MockListAddressesServiceMockRecorder struct {
+	mock *MockListAddressesService
+}
+
+// NewMockListAddressesService creates a new mock instance.
+func NewMockListAddressesService(ctrl *gomock.Controller) *MockListAddressesService {
+	mock := &MockListAddressesService{ctrl: ctrl}
+	mock.recorder = &MockListAddressesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListAddressesService) EXPECT() *MockListAddressesServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListAddressesService) List(ctx context.Context, userID string) ([]domain.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]domain.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListAddressesServiceMockRecorder) List(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListAddressesService)(nil).List), ctx, userID)
+}
@@ -0,0 +1,260 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/webhook/domain"
+	appdb "r2-challenge/pkg/db"
+	"r2-challenge/pkg/observability"
+)
+
+type dbWebhookRepository struct {
+	db     *gorm.DB
+	tracer observability.Tracer
+}
+
+func NewDBRepository(database *appdb.Database, t observability.Tracer) (WebhookRepository, error) {
+	return &dbWebhookRepository{db: database.DB, tracer: t}, nil
+}
+
+func (r *dbWebhookRepository) SaveSubscription(ctx context.Context, s domain.Subscription) (domain.Subscription, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.SaveSubscription")
+	defer span.End()
+
+	now := time.Now().UTC()
+	if s.ID == "" {
+		s.ID = uuid.NewString()
+	}
+	s.CreatedAt = now
+	s.UpdatedAt = now
+
+	if err := appdb.Conn(ctx, r.db).Table("webhook_subscriptions").Create(&s).Error; err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	return s, nil
+}
+
+func (r *dbWebhookRepository) UpdateSubscription(ctx context.Context, s domain.Subscription) (domain.Subscription, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.UpdateSubscription")
+	defer span.End()
+
+	s.UpdatedAt = time.Now().UTC()
+	columns := []string{"url", "events", "active", "updated_at"}
+	if s.Secret != "" {
+		columns = append(columns, "secret")
+	}
+
+	tx := appdb.Conn(ctx, r.db).Table("webhook_subscriptions").Where("id = ?", s.ID).Select(columns).Updates(&s)
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return domain.Subscription{}, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return domain.Subscription{}, gorm.ErrRecordNotFound
+	}
+
+	return r.GetSubscription(ctx, s.ID)
+}
+
+func (r *dbWebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.DeleteSubscription")
+	defer span.End()
+
+	tx := appdb.Conn(ctx, r.db).Table("webhook_subscriptions").Where("id = ?", id).Delete(&domain.Subscription{})
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *dbWebhookRepository) GetSubscription(ctx context.Context, id string) (domain.Subscription, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.GetSubscription")
+	defer span.End()
+
+	var s domain.Subscription
+	if err := appdb.Conn(ctx, r.db).Table("webhook_subscriptions").Where("id = ?", id).First(&s).Error; err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	return s, nil
+}
+
+func (r *dbWebhookRepository) ListSubscriptions(ctx context.Context) ([]domain.Subscription, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.ListSubscriptions")
+	defer span.End()
+
+	var list []domain.Subscription
+	if err := appdb.Conn(ctx, r.db).Table("webhook_subscriptions").Order("created_at").Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *dbWebhookRepository) ListActiveByEvent(ctx context.Context, event string) ([]domain.Subscription, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.ListActiveByEvent")
+	defer span.End()
+
+	filter, err := json.Marshal([]string{event})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var list []domain.Subscription
+	if err := appdb.Conn(ctx, r.db).Table("webhook_subscriptions").
+		Where("active AND events @> ?::jsonb", string(filter)).
+		Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *dbWebhookRepository) SaveDeliveries(ctx context.Context, deliveries []domain.Delivery) ([]domain.Delivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.SaveDeliveries")
+	defer span.End()
+
+	if len(deliveries) == 0 {
+		return deliveries, nil
+	}
+
+	now := time.Now().UTC()
+	for i := range deliveries {
+		if deliveries[i].ID == "" {
+			deliveries[i].ID = uuid.NewString()
+		}
+		deliveries[i].Status = domain.DeliveryPending
+		deliveries[i].NextAttemptAt = now
+		deliveries[i].CreatedAt = now
+		deliveries[i].UpdatedAt = now
+	}
+
+	if err := appdb.Conn(ctx, r.db).Table("webhook_deliveries").Create(&deliveries).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *dbWebhookRepository) GetDelivery(ctx context.Context, id string) (domain.Delivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.GetDelivery")
+	defer span.End()
+
+	var d domain.Delivery
+	if err := appdb.Conn(ctx, r.db).Table("webhook_deliveries").Where("id = ?", id).First(&d).Error; err != nil {
+		span.RecordError(err)
+		return domain.Delivery{}, err
+	}
+
+	return d, nil
+}
+
+func (r *dbWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int, offset int) ([]domain.Delivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.ListDeliveries")
+	defer span.End()
+
+	q := appdb.Conn(ctx, r.db).Table("webhook_deliveries").Where("subscription_id = ?", subscriptionID).Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	var list []domain.Delivery
+	if err := q.Find(&list).Error; err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *dbWebhookRepository) ClaimNextDelivery(ctx context.Context, now time.Time, leaseUntil time.Time) (domain.Delivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.ClaimNextDelivery")
+	defer span.End()
+
+	var list []domain.Delivery
+	tx := appdb.Conn(ctx, r.db).Raw(
+		`UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = ?
+		 WHERE id = (
+			SELECT id FROM webhook_deliveries WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, created_at LIMIT 1 FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING *`,
+		leaseUntil, now, domain.DeliveryPending, now,
+	).Scan(&list)
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		return domain.Delivery{}, tx.Error
+	}
+	if len(list) == 0 {
+		return domain.Delivery{}, gorm.ErrRecordNotFound
+	}
+
+	return list[0], nil
+}
+
+func (r *dbWebhookRepository) MarkDelivered(ctx context.Context, id string, attempts int, responseStatus int, at time.Time) error {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.MarkDelivered")
+	defer span.End()
+
+	err := appdb.Conn(ctx, r.db).Table("webhook_deliveries").Where("id = ?", id).Updates(map[string]any{
+		"status":          domain.DeliveryDelivered,
+		"attempts":        attempts,
+		"response_status": responseStatus,
+		"last_error":      "",
+		"delivered_at":    at,
+		"updated_at":      at,
+	}).Error
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *dbWebhookRepository) MarkDeliveryFailed(ctx context.Context, id string, attempts int, responseStatus int, lastErr string, nextAttemptAt time.Time, final bool) error {
+	ctx, span := r.tracer.StartSpan(ctx, "WebhookRepository.MarkDeliveryFailed")
+	defer span.End()
+
+	status := domain.DeliveryPending
+	if final {
+		status = domain.DeliveryFailed
+	}
+
+	err := appdb.Conn(ctx, r.db).Table("webhook_deliveries").Where("id = ?", id).Updates(map[string]any{
+		"status":          status,
+		"attempts":        attempts,
+		"response_status": responseStatus,
+		"last_error":      lastErr,
+		"next_attempt_at": nextAttemptAt,
+		"updated_at":      time.Now().UTC(),
+	}).Error
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
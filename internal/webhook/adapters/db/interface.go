@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"r2-challenge/internal/webhook/domain"
+)
+
+type WebhookRepository interface {
+	SaveSubscription(ctx context.Context, s domain.Subscription) (domain.Subscription, error)
+	// UpdateSubscription replaces url, events and active; the secret is only
+	// replaced when s.Secret is set.
+	UpdateSubscription(ctx context.Context, s domain.Subscription) (domain.Subscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	GetSubscription(ctx context.Context, id string) (domain.Subscription, error)
+	ListSubscriptions(ctx context.Context) ([]domain.Subscription, error)
+	ListActiveByEvent(ctx context.Context, event string) ([]domain.Subscription, error)
+
+	SaveDeliveries(ctx context.Context, deliveries []domain.Delivery) ([]domain.Delivery, error)
+	GetDelivery(ctx context.Context, id string) (domain.Delivery, error)
+	ListDeliveries(ctx context.Context, subscriptionID string, limit int, offset int) ([]domain.Delivery, error)
+	// ClaimNextDelivery takes the oldest pending delivery due at now, skipping
+	// deliveries locked by other workers, and marks it in flight by moving its
+	// next attempt to leaseUntil. The claim commits on its own, so the caller
+	// sends without holding a lock; if it never records a result, the delivery
+	// is due again once the lease ends. It returns gorm.ErrRecordNotFound when
+	// nothing is due.
+	ClaimNextDelivery(ctx context.Context, now time.Time, leaseUntil time.Time) (domain.Delivery, error)
+	MarkDelivered(ctx context.Context, id string, attempts int, responseStatus int, at time.Time) error
+	// MarkDeliveryFailed records a failed attempt and either schedules the
+	// next one at nextAttemptAt or, when final is set, fails the delivery.
+	MarkDeliveryFailed(ctx context.Context, id string, attempts int, responseStatus int, lastErr string, nextAttemptAt time.Time, final bool) error
+}
@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/adapters/db/interface.go
+
+// Package db is a generated GoMock package.
+package db
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimNextDelivery mocks base method.
+func (m *MockWebhookRepository) ClaimNextDelivery(ctx context.Context, now, leaseUntil time.Time) (domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNextDelivery", ctx, now, leaseUntil)
+	ret0, _ := ret[0].(domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNextDelivery indicates an expected call of ClaimNextDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) ClaimNextDelivery(ctx, now, leaseUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNextDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimNextDelivery), ctx, now, leaseUntil)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteSubscription(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteSubscription), ctx, id)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id string) (domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	ret0, _ := ret[0].(domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) GetDelivery(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetDelivery), ctx, id)
+}
+
+// GetSubscription mocks base method.
+func (m *MockWebhookRepository) GetSubscription(ctx context.Context, id string) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, id)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) GetSubscription(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).GetSubscription), ctx, id)
+}
+
+// ListActiveByEvent mocks base method.
+func (m *MockWebhookRepository) ListActiveByEvent(ctx context.Context, event string) ([]domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByEvent", ctx, event)
+	ret0, _ := ret[0].([]domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByEvent indicates an expected call of ListActiveByEvent.
+func (mr *MockWebhookRepositoryMockRecorder) ListActiveByEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByEvent", reflect.TypeOf((*MockWebhookRepository)(nil).ListActiveByEvent), ctx, event)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, subscriptionID, limit, offset)
+	ret0, _ := ret[0].([]domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ListDeliveries(ctx, subscriptionID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListDeliveries), ctx, subscriptionID, limit, offset)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookRepository) ListSubscriptions(ctx context.Context) ([]domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx)
+	ret0, _ := ret[0].([]domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) ListSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).ListSubscriptions), ctx)
+}
+
+// MarkDelivered mocks base method.
+func (m *MockWebhookRepository) MarkDelivered(ctx context.Context, id string, attempts, responseStatus int, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, id, attempts, responseStatus, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockWebhookRepositoryMockRecorder) MarkDelivered(ctx, id, attempts, responseStatus, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockWebhookRepository)(nil).MarkDelivered), ctx, id, attempts, responseStatus, at)
+}
+
+// MarkDeliveryFailed mocks base method.
+func (m *MockWebhookRepository) MarkDeliveryFailed(ctx context.Context, id string, attempts, responseStatus int, lastErr string, nextAttemptAt time.Time, final bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeliveryFailed", ctx, id, attempts, responseStatus, lastErr, nextAttemptAt, final)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeliveryFailed indicates an expected call of MarkDeliveryFailed.
+func (mr *MockWebhookRepositoryMockRecorder) MarkDeliveryFailed(ctx, id, attempts, responseStatus, lastErr, nextAttemptAt, final interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeliveryFailed", reflect.TypeOf((*MockWebhookRepository)(nil).MarkDeliveryFailed), ctx, id, attempts, responseStatus, lastErr, nextAttemptAt, final)
+}
+
+// SaveDeliveries mocks base method.
+func (m *MockWebhookRepository) SaveDeliveries(ctx context.Context, deliveries []domain.Delivery) ([]domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].([]domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDeliveries indicates an expected call of SaveDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) SaveDeliveries(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).SaveDeliveries), ctx, deliveries)
+}
+
+// SaveSubscription mocks base method.
+func (m *MockWebhookRepository) SaveSubscription(ctx context.Context, s domain.Subscription) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSubscription", ctx, s)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSubscription indicates an expected call of SaveSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) SaveSubscription(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).SaveSubscription), ctx, s)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookRepository) UpdateSubscription(ctx context.Context, s domain.Subscription) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, s)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateSubscription(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateSubscription), ctx, s)
+}
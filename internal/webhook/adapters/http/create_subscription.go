@@ -0,0 +1,87 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/internal/webhook/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateSubscriptionHandler struct {
+	service   command.CreateSubscriptionService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewCreateSubscriptionHandler(s command.CreateSubscriptionService, v *validator.Validate, t observability.Tracer) (CreateSubscriptionHandler, error) {
+	return CreateSubscriptionHandler{service: s, validator: v, tracer: t}, nil
+}
+
+type subscriptionRequest struct {
+	URL    string   `json:"url" validate:"required,url"`
+	Events []string `json:"events" validate:"required,min=1,dive,required"`
+	// optional; generated on create when empty, kept on update when empty
+	Secret string `json:"secret" validate:"omitempty,min=16"`
+	// defaults to true
+	Active *bool `json:"active"`
+}
+
+func (r subscriptionRequest) toDomain(id string) domain.Subscription {
+	active := true
+	if r.Active != nil {
+		active = *r.Active
+	}
+	return domain.Subscription{ID: id, URL: r.URL, Events: r.Events, Secret: r.Secret, Active: active}
+}
+
+// Create Webhook Subscription
+// @Summary      Create webhook subscription
+// @Description  Subscribe an endpoint to order/payment events. The response is the only one that includes the signing secret.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        subscription  body     subscriptionRequest  true  "Subscription input"
+// @Success      201           {object} domain.Subscription
+// @Failure      400           {object} map[string]string "Bad Request"
+// @Failure      401           {object} map[string]string "Unauthorized"
+// @Failure      403           {object} map[string]string "Forbidden"
+// @Failure      500           {object} map[string]string "Internal Server Error"
+// @Router       /webhooks [post]
+func (h CreateSubscriptionHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.CreateSubscription")
+	defer span.End()
+
+	var req subscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	created, err := h.service.Create(ctx, req.toDomain(""))
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, created)
+}
+
+func writeError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+	case errors.Is(err, domain.ErrUnknownEvent):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
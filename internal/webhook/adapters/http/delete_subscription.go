@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/webhook/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type DeleteSubscriptionHandler struct {
+	service   command.DeleteSubscriptionService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewDeleteSubscriptionHandler(s command.DeleteSubscriptionService, v *validator.Validate, t observability.Tracer) (DeleteSubscriptionHandler, error) {
+	return DeleteSubscriptionHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Delete Webhook Subscription
+// @Summary      Delete webhook subscription
+// @Description  Delete a subscription and its delivery log
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id   path     string  true  "Subscription ID"
+// @Success      204  {string} string  "No Content"
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /webhooks/{id} [delete]
+func (h DeleteSubscriptionHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.DeleteSubscription")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	if err := h.service.Delete(ctx, id); err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
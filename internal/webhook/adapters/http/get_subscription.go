@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/webhook/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type GetSubscriptionHandler struct {
+	service   query.GetSubscriptionService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewGetSubscriptionHandler(s query.GetSubscriptionService, v *validator.Validate, t observability.Tracer) (GetSubscriptionHandler, error) {
+	return GetSubscriptionHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Get Webhook Subscription
+// @Summary      Get webhook subscription
+// @Description  Get a subscription by ID (secret omitted)
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id   path     string  true  "Subscription ID"
+// @Success      200  {object} domain.Subscription
+// @Failure      400  {object} map[string]string "Bad Request"
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      404  {object} map[string]string "Not Found"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /webhooks/{id} [get]
+func (h GetSubscriptionHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.GetSubscription")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	sub, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, sub)
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/webhook/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListDeliveriesHandler struct {
+	service   query.ListDeliveriesService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListDeliveriesHandler(s query.ListDeliveriesService, v *validator.Validate, t observability.Tracer) (ListDeliveriesHandler, error) {
+	return ListDeliveriesHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Webhook Deliveries
+// @Summary      List webhook deliveries
+// @Description  Delivery log of a subscription, newest first
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id      path     string  true   "Subscription ID"
+// @Param        limit   query    int     false  "Limit"
+// @Param        offset  query    int     false  "Offset"
+// @Success      200     {array}  domain.Delivery
+// @Failure      400     {object} map[string]string "Bad Request"
+// @Failure      401     {object} map[string]string "Unauthorized"
+// @Failure      403     {object} map[string]string "Forbidden"
+// @Failure      404     {object} map[string]string "Not Found"
+// @Failure      500     {object} map[string]string "Internal Server Error"
+// @Router       /webhooks/{id}/deliveries [get]
+func (h ListDeliveriesHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.ListDeliveries")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	limit := 50
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if l, err := strconv.Atoi(limitParam); err == nil {
+			limit = l
+		}
+	}
+
+	offset := 0
+	if offsetParam := c.QueryParam("offset"); offsetParam != "" {
+		if o, err := strconv.Atoi(offsetParam); err == nil {
+			offset = o
+		}
+	}
+
+	list, err := h.service.List(ctx, id, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
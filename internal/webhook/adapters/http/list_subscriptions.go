@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/webhook/services/query"
+	"r2-challenge/pkg/observability"
+)
+
+type ListSubscriptionsHandler struct {
+	service   query.ListSubscriptionsService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewListSubscriptionsHandler(s query.ListSubscriptionsService, v *validator.Validate, t observability.Tracer) (ListSubscriptionsHandler, error) {
+	return ListSubscriptionsHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// List Webhook Subscriptions
+// @Summary      List webhook subscriptions
+// @Description  List all subscriptions (secrets omitted)
+// @Tags         Webhooks
+// @Produce      json
+// @Success      200  {array}  domain.Subscription
+// @Failure      401  {object} map[string]string "Unauthorized"
+// @Failure      403  {object} map[string]string "Forbidden"
+// @Failure      500  {object} map[string]string "Internal Server Error"
+// @Router       /webhooks [get]
+func (h ListSubscriptionsHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.ListSubscriptions")
+	defer span.End()
+
+	list, err := h.service.List(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, list)
+}
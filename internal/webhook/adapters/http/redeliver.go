@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/webhook/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type RedeliverHandler struct {
+	service   command.RedeliverService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewRedeliverHandler(s command.RedeliverService, v *validator.Validate, t observability.Tracer) (RedeliverHandler, error) {
+	return RedeliverHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Redeliver Webhook
+// @Summary      Redeliver webhook
+// @Description  Queue a new delivery of the same event body; the original delivery stays in the log
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id          path     string  true  "Subscription ID"
+// @Param        deliveryId  path     string  true  "Delivery ID"
+// @Success      202         {object} domain.Delivery
+// @Failure      400         {object} map[string]string "Bad Request"
+// @Failure      401         {object} map[string]string "Unauthorized"
+// @Failure      403         {object} map[string]string "Forbidden"
+// @Failure      404         {object} map[string]string "Not Found"
+// @Failure      500         {object} map[string]string "Internal Server Error"
+// @Router       /webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h RedeliverHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.Redeliver")
+	defer span.End()
+
+	id := c.Param("id")
+	deliveryID := c.Param("deliveryId")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	if err := h.validator.Var(deliveryID, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid delivery id"})
+	}
+
+	delivery, err := h.service.Redeliver(ctx, id, deliveryID)
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, delivery)
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"r2-challenge/internal/webhook/services/command"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateSubscriptionHandler struct {
+	service   command.UpdateSubscriptionService
+	validator *validator.Validate
+	tracer    observability.Tracer
+}
+
+func NewUpdateSubscriptionHandler(s command.UpdateSubscriptionService, v *validator.Validate, t observability.Tracer) (UpdateSubscriptionHandler, error) {
+	return UpdateSubscriptionHandler{service: s, validator: v, tracer: t}, nil
+}
+
+// Update Webhook Subscription
+// @Summary      Update webhook subscription
+// @Description  Replace url, events and active flag; a non-empty secret rotates the signing secret
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id            path     string               true  "Subscription ID"
+// @Param        subscription  body     subscriptionRequest  true  "Subscription input"
+// @Success      200           {object} domain.Subscription
+// @Failure      400           {object} map[string]string "Bad Request"
+// @Failure      401           {object} map[string]string "Unauthorized"
+// @Failure      403           {object} map[string]string "Forbidden"
+// @Failure      404           {object} map[string]string "Not Found"
+// @Failure      500           {object} map[string]string "Internal Server Error"
+// @Router       /webhooks/{id} [put]
+func (h UpdateSubscriptionHandler) Handle(c echo.Context) error {
+	ctx, span := h.tracer.StartSpan(c.Request().Context(), "WebhookHTTP.UpdateSubscription")
+	defer span.End()
+
+	id := c.Param("id")
+	if err := h.validator.Var(id, "required,uuid"); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req subscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid body"})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		span.RecordError(err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	updated, err := h.service.Update(ctx, req.toDomain(id))
+	if err != nil {
+		span.RecordError(err)
+		return writeError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, updated.Redacted())
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	orderdomain "r2-challenge/internal/order/domain"
+	pmtdomain "r2-challenge/internal/payment/domain"
+	returndomain "r2-challenge/internal/returns/domain"
+)
+
+// Delivery statuses.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	// retries exhausted or the subscription was deactivated
+	DeliveryFailed = "failed"
+)
+
+// Events lists the event types a subscription can listen to.
+var Events = []string{
+	orderdomain.TopicOrderPlaced,
+	orderdomain.TopicOrderStatusChanged,
+	orderdomain.TopicOrderExpired,
+	orderdomain.TopicShipmentCreated,
+	orderdomain.TopicShipmentDelivered,
+	pmtdomain.TopicPaymentAuthorized,
+	pmtdomain.TopicPaymentCaptured,
+	pmtdomain.TopicPaymentRefunded,
+	pmtdomain.TopicPaymentVoided,
+	pmtdomain.TopicPaymentFailed,
+	pmtdomain.TopicPaymentChargedBack,
+	returndomain.TopicReturnRequested,
+	returndomain.TopicReturnApproved,
+	returndomain.TopicReturnRejected,
+	returndomain.TopicReturnReceived,
+}
+
+// ErrUnknownEvent is returned when a subscription lists an event type that is not in Events.
+var ErrUnknownEvent = errors.New("unknown webhook event")
+
+// Subscription is an endpoint that receives signed deliveries of the events it lists.
+type Subscription struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid"`
+	URL       string    `json:"url" validate:"required,url"`
+	Events    []string  `json:"events" gorm:"serializer:json" validate:"required,min=1"`
+	Secret    string    `json:"secret,omitempty"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Redacted returns the subscription without its signing secret.
+func (s Subscription) Redacted() Subscription {
+	s.Secret = ""
+	return s
+}
+
+// ValidateEvents checks that every listed event type is supported.
+func ValidateEvents(events []string) error {
+	for _, e := range events {
+		known := false
+		for _, k := range Events {
+			if e == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%w: %q", ErrUnknownEvent, e)
+		}
+	}
+	return nil
+}
+
+// Delivery is one attempt log entry for sending an event to a subscription.
+type Delivery struct {
+	ID             string          `json:"id" gorm:"primaryKey;type:uuid"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"response_status,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Envelope is the JSON body posted to subscribers. ID identifies the event
+// and stays the same across retries and redeliveries.
+type Envelope struct {
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
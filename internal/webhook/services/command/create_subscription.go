@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type CreateSubscriptionService interface {
+	// Create stores the subscription, generating a signing secret when none
+	// is given. The returned subscription carries the secret.
+	Create(ctx context.Context, s domain.Subscription) (domain.Subscription, error)
+}
+
+type createSubscriptionService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewCreateSubscriptionService(r repo.WebhookRepository, t observability.Tracer) (CreateSubscriptionService, error) {
+	return &createSubscriptionService{repo: r, tracer: t}, nil
+}
+
+func (s *createSubscriptionService) Create(ctx context.Context, sub domain.Subscription) (domain.Subscription, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookCommand.CreateSubscription")
+	defer span.End()
+
+	if err := domain.ValidateEvents(sub.Events); err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	if sub.Secret == "" {
+		secret, err := newSecret()
+		if err != nil {
+			span.RecordError(err)
+			return domain.Subscription{}, err
+		}
+		sub.Secret = secret
+	}
+
+	saved, err := s.repo.SaveSubscription(ctx, sub)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	return saved, nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/command/create_subscription.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateSubscriptionService is a mock of CreateSubscriptionService interface.
+type MockCreateSubscriptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSubscriptionServiceMockRecorder
+}
+
+// MockCreateSubscriptionServiceMockRecorder is the mock recorder for MockCreateSubscriptionService.
+type MockCreateSubscriptionServiceMockRecorder struct {
+	mock *MockCreateSubscriptionService
+}
+
+// NewMockCreateSubscriptionService creates a new mock instance.
+func NewMockCreateSubscriptionService(ctrl *gomock.Controller) *MockCreateSubscriptionService {
+	mock := &MockCreateSubscriptionService{ctrl: ctrl}
+	mock.recorder = &MockCreateSubscriptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSubscriptionService) EXPECT() *MockCreateSubscriptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateSubscriptionService) Create(ctx context.Context, s domain.Subscription) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateSubscriptionServiceMockRecorder) Create(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateSubscriptionService)(nil).Create), ctx, s)
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestCreateSubscription_GeneratesSecret(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockWebhookRepository(ctrl)
+	s, _ := NewCreateSubscriptionService(r, tracer)
+
+	r.EXPECT().SaveSubscription(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sub domain.Subscription) (domain.Subscription, error) {
+		sub.ID = "s1"
+		return sub, nil
+	})
+
+	created, err := s.Create(context.Background(), domain.Subscription{URL: "https://erp.example.com/hooks", Events: []string{"order.placed"}, Active: true})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !strings.HasPrefix(created.Secret, "whsec_") {
+		t.Fatalf("expected generated secret, got %q", created.Secret)
+	}
+}
+
+func TestCreateSubscription_RejectsUnknownEvent(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	s, _ := NewCreateSubscriptionService(repo.NewMockWebhookRepository(ctrl), tracer)
+
+	_, err := s.Create(context.Background(), domain.Subscription{URL: "https://erp.example.com/hooks", Events: []string{"order.exploded"}})
+	if !errors.Is(err, domain.ErrUnknownEvent) {
+		t.Fatalf("expected ErrUnknownEvent, got %v", err)
+	}
+}
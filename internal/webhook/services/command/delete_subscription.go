@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/pkg/observability"
+)
+
+type DeleteSubscriptionService interface {
+	// Delete removes the subscription together with its delivery log.
+	Delete(ctx context.Context, id string) error
+}
+
+type deleteSubscriptionService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewDeleteSubscriptionService(r repo.WebhookRepository, t observability.Tracer) (DeleteSubscriptionService, error) {
+	return &deleteSubscriptionService{repo: r, tracer: t}, nil
+}
+
+func (s *deleteSubscriptionService) Delete(ctx context.Context, id string) error {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookCommand.DeleteSubscription")
+	defer span.End()
+
+	if err := s.repo.DeleteSubscription(ctx, id); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/command/delete_subscription.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeleteSubscriptionService is a mock of DeleteSubscriptionService interface.
+type MockDeleteSubscriptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSubscriptionServiceMockRecorder
+}
+
+// MockDeleteSubscriptionServiceMockRecorder is the mock recorder for MockDeleteSubscriptionService.
+type MockDeleteSubscriptionServiceMockRecorder struct {
+	mock *MockDeleteSubscriptionService
+}
+
+// NewMockDeleteSubscriptionService creates a new mock instance.
+func NewMockDeleteSubscriptionService(ctrl *gomock.Controller) *MockDeleteSubscriptionService {
+	mock := &MockDeleteSubscriptionService{ctrl: ctrl}
+	mock.recorder = &MockDeleteSubscriptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSubscriptionService) EXPECT() *MockDeleteSubscriptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeleteSubscriptionService) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeleteSubscriptionServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteSubscriptionService)(nil).Delete), ctx, id)
+}
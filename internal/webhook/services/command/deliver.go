@@ -0,0 +1,144 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+	"r2-challenge/pkg/worker"
+)
+
+const (
+	deliverBatchSize = 20
+	maxRetryBackoff  = 6 * time.Hour
+	// claimed deliveries stay in flight this long past the send timeout
+	// before another worker may pick them up again
+	claimLeaseMargin = time.Minute
+)
+
+type DeliverService interface {
+	// DeliverDue attempts due deliveries, up to a batch, and returns how many
+	// were accepted by their endpoint.
+	DeliverDue(ctx context.Context) (int, error)
+}
+
+type deliverService struct {
+	repo        repo.WebhookRepository
+	client      *http.Client
+	tracer      observability.Tracer
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+func NewDeliverService(r repo.WebhookRepository, e envs.Envs, t observability.Tracer) (DeliverService, error) {
+	timeout, err := time.ParseDuration(e.WebhookTimeout)
+	if err != nil || timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	backoff, err := time.ParseDuration(e.WebhookRetryBackoff)
+	if err != nil || backoff <= 0 {
+		backoff = 30 * time.Second
+	}
+	maxAttempts := e.WebhookMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+
+	return &deliverService{
+		repo:        r,
+		client:      &http.Client{Timeout: timeout},
+		tracer:      t,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		now:         func() time.Time { return time.Now().UTC() },
+	}, nil
+}
+
+func (s *deliverService) DeliverDue(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookCommand.DeliverDue")
+	defer span.End()
+
+	now := s.now()
+	delivered := 0
+	for i := 0; i < deliverBatchSize; i++ {
+		// the claim commits before sending, so no row lock or transaction is
+		// held while waiting on the subscriber's endpoint
+		d, err := s.repo.ClaimNextDelivery(ctx, now, s.now().Add(s.client.Timeout+claimLeaseMargin))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return delivered, nil
+		}
+		if err != nil {
+			span.RecordError(err)
+			return delivered, err
+		}
+
+		ok, err := s.attempt(ctx, d)
+		if ok {
+			delivered++
+		}
+		if err != nil {
+			span.RecordError(err)
+			return delivered, err
+		}
+	}
+
+	return delivered, nil
+}
+
+// attempt sends one delivery and records the outcome, scheduling a retry
+// with exponential backoff until attempts are exhausted.
+func (s *deliverService) attempt(ctx context.Context, d domain.Delivery) (bool, error) {
+	attempts := d.Attempts + 1
+
+	sub, err := s.repo.GetSubscription(ctx, d.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+	if !sub.Active {
+		return false, s.repo.MarkDeliveryFailed(ctx, d.ID, d.Attempts, 0, "subscription inactive", s.now(), true)
+	}
+
+	status, sendErr := s.send(ctx, sub, d)
+	if sendErr == nil {
+		return true, s.repo.MarkDelivered(ctx, d.ID, attempts, status, s.now())
+	}
+
+	final := attempts >= s.maxAttempts
+	return false, s.repo.MarkDeliveryFailed(ctx, d.ID, attempts, status, sendErr.Error(), s.now().Add(worker.Backoff(s.backoff, attempts, maxRetryBackoff)), final)
+}
+
+func (s *deliverService) send(ctx context.Context, sub domain.Subscription, d domain.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(domain.HeaderID, d.EventID)
+	req.Header.Set(domain.HeaderEvent, d.Event)
+	req.Header.Set(domain.HeaderSignature, domain.Sign(sub.Secret, s.now(), d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
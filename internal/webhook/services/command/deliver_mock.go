@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/command/deliver.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeliverService is a mock of DeliverService interface.
+type MockDeliverService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliverServiceMockRecorder
+}
+
+// MockDeliverServiceMockRecorder is the mock recorder for MockDeliverService.
+type MockDeliverServiceMockRecorder struct {
+	mock *MockDeliverService
+}
+
+// NewMockDeliverService creates a new mock instance.
+func NewMockDeliverService(ctrl *gomock.Controller) *MockDeliverService {
+	mock := &MockDeliverService{ctrl: ctrl}
+	mock.recorder = &MockDeliverServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliverService) EXPECT() *MockDeliverServiceMockRecorder {
+	return m.recorder
+}
+
+// DeliverDue mocks base method.
+func (m *MockDeliverService) DeliverDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverDue indicates an expected call of DeliverDue.
+func (mr *MockDeliverServiceMockRecorder) DeliverDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverDue", reflect.TypeOf((*MockDeliverService)(nil).DeliverDue), ctx)
+}
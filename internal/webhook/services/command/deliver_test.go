@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+
+	"r2-challenge/cmd/envs"
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+var fixedNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// leaseEnd is when a delivery claimed at fixedNow is due again: the 2s send
+// timeout plus the lease margin.
+var leaseEnd = fixedNow.Add(2*time.Second + claimLeaseMargin)
+
+func newDeliverer(t *testing.T, r repo.WebhookRepository) *deliverService {
+	t.Helper()
+	tracer, _ := observability.SetupTracer()
+	s, err := NewDeliverService(r, envs.Envs{WebhookMaxAttempts: 3, WebhookRetryBackoff: "10s", WebhookTimeout: "2s"}, tracer)
+	if err != nil {
+		t.Fatalf("failed to build service: %v", err)
+	}
+	d := s.(*deliverService)
+	d.now = func() time.Time { return fixedNow }
+	return d
+}
+
+func TestDeliverDue_SignsAndMarksDelivered(t *testing.T) {
+	body := []byte(`{"id":"e1","event":"order.placed","data":{}}`)
+	var gotSig, gotEvent, gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSig = r.Header.Get(domain.HeaderSignature)
+		gotEvent = r.Header.Get(domain.HeaderEvent)
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	r := repo.NewMockWebhookRepository(ctrl)
+	d := newDeliverer(t, r)
+
+	gomock.InOrder(
+		r.EXPECT().ClaimNextDelivery(gomock.Any(), fixedNow, leaseEnd).Return(domain.Delivery{ID: "d1", SubscriptionID: "s1", EventID: "e1", Event: "order.placed", Payload: body}, nil),
+		r.EXPECT().GetSubscription(gomock.Any(), "s1").Return(domain.Subscription{ID: "s1", URL: srv.URL, Secret: "topsecret", Active: true}, nil),
+		r.EXPECT().MarkDelivered(gomock.Any(), "d1", 1, http.StatusNoContent, fixedNow).Return(nil),
+		r.EXPECT().ClaimNextDelivery(gomock.Any(), fixedNow, leaseEnd).Return(domain.Delivery{}, gorm.ErrRecordNotFound),
+	)
+
+	n, err := d.DeliverDue(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("expected 1 delivered, got %d, %v", n, err)
+	}
+	if gotBody != string(body) || gotEvent != "order.placed" {
+		t.Fatalf("unexpected request: event=%q body=%q", gotEvent, gotBody)
+	}
+	if want := domain.Sign("topsecret", fixedNow, body); gotSig != want {
+		t.Fatalf("signature mismatch: got %q want %q", gotSig, want)
+	}
+}
+
+func TestDeliverDue_ErrorResponseSchedulesRetry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	r := repo.NewMockWebhookRepository(ctrl)
+	d := newDeliverer(t, r)
+
+	gomock.InOrder(
+		r.EXPECT().ClaimNextDelivery(gomock.Any(), fixedNow, leaseEnd).Return(domain.Delivery{ID: "d1", SubscriptionID: "s1", Attempts: 1, Payload: []byte(`{}`)}, nil),
+		r.EXPECT().GetSubscription(gomock.Any(), "s1").Return(domain.Subscription{ID: "s1", URL: srv.URL, Secret: "topsecret", Active: true}, nil),
+		// second attempt: 10s base doubled once
+		r.EXPECT().MarkDeliveryFailed(gomock.Any(), "d1", 2, http.StatusInternalServerError, gomock.Any(), fixedNow.Add(20*time.Second), false).Return(nil),
+		r.EXPECT().ClaimNextDelivery(gomock.Any(), fixedNow, leaseEnd).Return(domain.Delivery{}, gorm.ErrRecordNotFound),
+	)
+
+	if n, err := d.DeliverDue(context.Background()); err != nil || n != 0 {
+		t.Fatalf("expected 0 delivered, got %d, %v", n, err)
+	}
+}
+
+func TestDeliverDue_LastAttemptFailsDelivery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	r := repo.NewMockWebhookRepository(ctrl)
+	d := newDeliverer(t, r)
+
+	gomock.InOrder(
+		r.EXPECT().ClaimNextDelivery(gomock.Any(), fixedNow, leaseEnd).Return(domain.Delivery{ID: "d1", SubscriptionID: "s1", Attempts: 2, Payload: []byte(`{}`)}, nil),
+		r.EXPECT().GetSubscription(gomock.Any(), "s1").Return(domain.Subscription{ID: "s1", URL: srv.URL, Active: true}, nil),
+		r.EXPECT().MarkDeliveryFailed(gomock.Any(), "d1", 3, http.StatusGone, gomock.Any(), gomock.Any(), true).Return(nil),
+		r.EXPECT().ClaimNextDelivery(gomock.Any(), fixedNow, leaseEnd).Return(domain.Delivery{}, gorm.ErrRecordNotFound),
+	)
+
+	if _, err := d.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+
+	outboxdomain "r2-challenge/internal/outbox/domain"
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type EnqueueDeliveriesService interface {
+	// Enqueue fans an outbox event out into one pending delivery per active
+	// subscription listening to it. It is subscribed to the outbox, so it
+	// runs inside the dispatcher transaction.
+	Enqueue(ctx context.Context, event outboxdomain.Event) error
+}
+
+type enqueueDeliveriesService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewEnqueueDeliveriesService(r repo.WebhookRepository, t observability.Tracer) (EnqueueDeliveriesService, error) {
+	return &enqueueDeliveriesService{repo: r, tracer: t}, nil
+}
+
+func (s *enqueueDeliveriesService) Enqueue(ctx context.Context, event outboxdomain.Event) error {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookCommand.Enqueue")
+	defer span.End()
+
+	subs, err := s.repo.ListActiveByEvent(ctx, event.Topic)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(domain.Envelope{
+		ID:        event.ID,
+		Event:     event.Topic,
+		CreatedAt: event.CreatedAt,
+		Data:      event.Payload,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	deliveries := make([]domain.Delivery, 0, len(subs))
+	for _, sub := range subs {
+		deliveries = append(deliveries, domain.Delivery{
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			Event:          event.Topic,
+			Payload:        body,
+		})
+	}
+
+	if _, err := s.repo.SaveDeliveries(ctx, deliveries); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
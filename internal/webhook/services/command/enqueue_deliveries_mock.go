@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/command/enqueue_deliveries.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/outbox/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEnqueueDeliveriesService is a mock of EnqueueDeliveriesService interface.
+type MockEnqueueDeliveriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnqueueDeliveriesServiceMockRecorder
+}
+
+// MockEnqueueDeliveriesServiceMockRecorder is the mock recorder for MockEnqueueDeliveriesService.
+type MockEnqueueDeliveriesServiceMockRecorder struct {
+	mock *MockEnqueueDeliveriesService
+}
+
+// NewMockEnqueueDeliveriesService creates a new mock instance.
+func NewMockEnqueueDeliveriesService(ctrl *gomock.Controller) *MockEnqueueDeliveriesService {
+	mock := &MockEnqueueDeliveriesService{ctrl: ctrl}
+	mock.recorder = &MockEnqueueDeliveriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnqueueDeliveriesService) EXPECT() *MockEnqueueDeliveriesServiceMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockEnqueueDeliveriesService) Enqueue(ctx context.Context, event domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockEnqueueDeliveriesServiceMockRecorder) Enqueue(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockEnqueueDeliveriesService)(nil).Enqueue), ctx, event)
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+
+	outboxdomain "r2-challenge/internal/outbox/domain"
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+func TestEnqueue_OneDeliveryPerSubscription(t *testing.T) {
+	tracer, _ := observability.SetupTracer()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := repo.NewMockWebhookRepository(ctrl)
+	s, _ := NewEnqueueDeliveriesService(r, tracer)
+
+	r.EXPECT().ListActiveByEvent(gomock.Any(), "order.placed").Return([]domain.Subscription{{ID: "s1"}, {ID: "s2"}}, nil)
+	r.EXPECT().SaveDeliveries(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ds []domain.Delivery) ([]domain.Delivery, error) {
+		if len(ds) != 2 || ds[0].SubscriptionID != "s1" || ds[1].SubscriptionID != "s2" {
+			t.Fatalf("unexpected deliveries: %+v", ds)
+		}
+		var env domain.Envelope
+		if err := json.Unmarshal(ds[0].Payload, &env); err != nil {
+			t.Fatalf("payload is not an envelope: %v", err)
+		}
+		if env.ID != "e1" || env.Event != "order.placed" || string(env.Data) != `{"id":"o1"}` {
+			t.Fatalf("unexpected envelope: %+v", env)
+		}
+		return ds, nil
+	})
+
+	err := s.Enqueue(context.Background(), outboxdomain.Event{ID: "e1", Topic: "order.placed", Payload: []byte(`{"id":"o1"}`)})
+	if err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+}
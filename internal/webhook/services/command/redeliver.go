@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type RedeliverService interface {
+	// Redeliver queues a new delivery of the same event body. The original
+	// delivery stays in the log untouched.
+	Redeliver(ctx context.Context, subscriptionID string, deliveryID string) (domain.Delivery, error)
+}
+
+type redeliverService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewRedeliverService(r repo.WebhookRepository, t observability.Tracer) (RedeliverService, error) {
+	return &redeliverService{repo: r, tracer: t}, nil
+}
+
+func (s *redeliverService) Redeliver(ctx context.Context, subscriptionID string, deliveryID string) (domain.Delivery, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookCommand.Redeliver")
+	defer span.End()
+
+	original, err := s.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Delivery{}, err
+	}
+	if original.SubscriptionID != subscriptionID {
+		span.RecordError(gorm.ErrRecordNotFound)
+		return domain.Delivery{}, gorm.ErrRecordNotFound
+	}
+
+	saved, err := s.repo.SaveDeliveries(ctx, []domain.Delivery{{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		Event:          original.Event,
+		Payload:        original.Payload,
+	}})
+	if err != nil {
+		span.RecordError(err)
+		return domain.Delivery{}, err
+	}
+
+	return saved[0], nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/command/redeliver.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRedeliverService is a mock of RedeliverService interface.
+type MockRedeliverService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedeliverServiceMockRecorder
+}
+
+// MockRedeliverServiceMockRecorder is the mock recorder for MockRedeliverService.
+type MockRedeliverServiceMockRecorder struct {
+	mock *MockRedeliverService
+}
+
+// NewMockRedeliverService creates a new mock instance.
+func NewMockRedeliverService(ctrl *gomock.Controller) *MockRedeliverService {
+	mock := &MockRedeliverService{ctrl: ctrl}
+	mock.recorder = &MockRedeliverServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedeliverService) EXPECT() *MockRedeliverServiceMockRecorder {
+	return m.recorder
+}
+
+// Redeliver mocks base method.
+func (m *MockRedeliverService) Redeliver(ctx context.Context, subscriptionID, deliveryID string) (domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, subscriptionID, deliveryID)
+	ret0, _ := ret[0].(domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockRedeliverServiceMockRecorder) Redeliver(ctx, subscriptionID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockRedeliverService)(nil).Redeliver), ctx, subscriptionID, deliveryID)
+}
@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type UpdateSubscriptionService interface {
+	Update(ctx context.Context, s domain.Subscription) (domain.Subscription, error)
+}
+
+type updateSubscriptionService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewUpdateSubscriptionService(r repo.WebhookRepository, t observability.Tracer) (UpdateSubscriptionService, error) {
+	return &updateSubscriptionService{repo: r, tracer: t}, nil
+}
+
+func (s *updateSubscriptionService) Update(ctx context.Context, sub domain.Subscription) (domain.Subscription, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookCommand.UpdateSubscription")
+	defer span.End()
+
+	if err := domain.ValidateEvents(sub.Events); err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	updated, err := s.repo.UpdateSubscription(ctx, sub)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	return updated, nil
+}
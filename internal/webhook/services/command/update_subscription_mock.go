@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/command/update_subscription.go
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUpdateSubscriptionService is a mock of UpdateSubscriptionService interface.
+type MockUpdateSubscriptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateSubscriptionServiceMockRecorder
+}
+
+// MockUpdateSubscriptionServiceMockRecorder is the mock recorder for MockUpdateSubscriptionService.
+type MockUpdateSubscriptionServiceMockRecorder struct {
+	mock *MockUpdateSubscriptionService
+}
+
+// NewMockUpdateSubscriptionService creates a new mock instance.
+func NewMockUpdateSubscriptionService(ctrl *gomock.Controller) *MockUpdateSubscriptionService {
+	mock := &MockUpdateSubscriptionService{ctrl: ctrl}
+	mock.recorder = &MockUpdateSubscriptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateSubscriptionService) EXPECT() *MockUpdateSubscriptionServiceMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateSubscriptionService) Update(ctx context.Context, s domain.Subscription) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, s)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateSubscriptionServiceMockRecorder) Update(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateSubscriptionService)(nil).Update), ctx, s)
+}
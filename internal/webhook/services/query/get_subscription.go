@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type GetSubscriptionService interface {
+	GetByID(ctx context.Context, id string) (domain.Subscription, error)
+}
+
+type getSubscriptionService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewGetSubscriptionService(r repo.WebhookRepository, t observability.Tracer) (GetSubscriptionService, error) {
+	return &getSubscriptionService{repo: r, tracer: t}, nil
+}
+
+func (s *getSubscriptionService) GetByID(ctx context.Context, id string) (domain.Subscription, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookQuery.GetSubscription")
+	defer span.End()
+
+	sub, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return domain.Subscription{}, err
+	}
+
+	return sub.Redacted(), nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/query/get_subscription.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetSubscriptionService is a mock of GetSubscriptionService interface.
+type MockGetSubscriptionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSubscriptionServiceMockRecorder
+}
+
+// MockGetSubscriptionServiceMockRecorder is the mock recorder for MockGetSubscriptionService.
+type MockGetSubscriptionServiceMockRecorder struct {
+	mock *MockGetSubscriptionService
+}
+
+// NewMockGetSubscriptionService creates a new mock instance.
+func NewMockGetSubscriptionService(ctrl *gomock.Controller) *MockGetSubscriptionService {
+	mock := &MockGetSubscriptionService{ctrl: ctrl}
+	mock.recorder = &MockGetSubscriptionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSubscriptionService) EXPECT() *MockGetSubscriptionServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockGetSubscriptionService) GetByID(ctx context.Context, id string) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetSubscriptionServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetSubscriptionService)(nil).GetByID), ctx, id)
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListDeliveriesService interface {
+	// List returns the delivery log of a subscription, newest first.
+	List(ctx context.Context, subscriptionID string, limit int, offset int) ([]domain.Delivery, error)
+}
+
+type listDeliveriesService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewListDeliveriesService(r repo.WebhookRepository, t observability.Tracer) (ListDeliveriesService, error) {
+	return &listDeliveriesService{repo: r, tracer: t}, nil
+}
+
+func (s *listDeliveriesService) List(ctx context.Context, subscriptionID string, limit int, offset int) ([]domain.Delivery, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookQuery.ListDeliveries")
+	defer span.End()
+
+	if _, err := s.repo.GetSubscription(ctx, subscriptionID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	list, err := s.repo.ListDeliveries(ctx, subscriptionID, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/query/list_deliveries.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListDeliveriesService is a mock of ListDeliveriesService interface.
+type MockListDeliveriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDeliveriesServiceMockRecorder
+}
+
+// MockListDeliveriesServiceMockRecorder is the mock recorder for MockListDeliveriesService.
+type MockListDeliveriesServiceMockRecorder struct {
+	mock *MockListDeliveriesService
+}
+
+// NewMockListDeliveriesService creates a new mock instance.
+func NewMockListDeliveriesService(ctrl *gomock.Controller) *MockListDeliveriesService {
+	mock := &MockListDeliveriesService{ctrl: ctrl}
+	mock.recorder = &MockListDeliveriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDeliveriesService) EXPECT() *MockListDeliveriesServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListDeliveriesService) List(ctx context.Context, subscriptionID string, limit, offset int) ([]domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, subscriptionID, limit, offset)
+	ret0, _ := ret[0].([]domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListDeliveriesServiceMockRecorder) List(ctx, subscriptionID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListDeliveriesService)(nil).List), ctx, subscriptionID, limit, offset)
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	repo "r2-challenge/internal/webhook/adapters/db"
+	"r2-challenge/internal/webhook/domain"
+	"r2-challenge/pkg/observability"
+)
+
+type ListSubscriptionsService interface {
+	List(ctx context.Context) ([]domain.Subscription, error)
+}
+
+type listSubscriptionsService struct {
+	repo   repo.WebhookRepository
+	tracer observability.Tracer
+}
+
+func NewListSubscriptionsService(r repo.WebhookRepository, t observability.Tracer) (ListSubscriptionsService, error) {
+	return &listSubscriptionsService{repo: r, tracer: t}, nil
+}
+
+func (s *listSubscriptionsService) List(ctx context.Context) ([]domain.Subscription, error) {
+	ctx, span := s.tracer.StartSpan(ctx, "WebhookQuery.ListSubscriptions")
+	defer span.End()
+
+	list, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	for i := range list {
+		list[i] = list[i].Redacted()
+	}
+
+	return list, nil
+}
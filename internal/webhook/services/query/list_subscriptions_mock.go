@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/webhook/services/query/list_subscriptions.go
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	domain "r2-challenge/internal/webhook/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListSubscriptionsService is a mock of ListSubscriptionsService interface.
+type MockListSubscriptionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockListSubscriptionsServiceMockRecorder
+}
+
+// MockListSubscriptionsServiceMockRecorder is the mock recorder for MockListSubscriptionsService.
+type MockListSubscriptionsServiceMockRecorder struct {
+	mock *MockListSubscriptionsService
+}
+
+// NewMockListSubscriptionsService creates a new mock instance.
+func NewMockListSubscriptionsService(ctrl *gomock.Controller) *MockListSubscriptionsService {
+	mock := &MockListSubscriptionsService{ctrl: ctrl}
+	mock.recorder = &MockListSubscriptionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListSubscriptionsService) EXPECT() *MockListSubscriptionsServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockListSubscriptionsService) List(ctx context.Context) ([]domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListSubscriptionsServiceMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListSubscriptionsService)(nil).List), ctx)
+}
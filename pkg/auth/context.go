@@ -0,0 +1,18 @@
+package auth
+
+import "context"
+
+type userIDKey struct{}
+
+// WithUserID returns a copy of ctx carrying the authenticated user's id, so
+// code below the HTTP layer can tell who made a change.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFrom returns the user id stored by WithUserID, or "" when the work
+// was not started by an authenticated request (e.g. a background job).
+func UserIDFrom(ctx context.Context) string {
+	userID, _ := ctx.Value(userIDKey{}).(string)
+	return userID
+}
@@ -44,6 +44,7 @@ func JWTMiddleware(tm TokenManager, isPublic func(method, path string) bool) ech
 
 			if sub, ok := claims["sub"].(string); ok {
 				c.Set(CtxUserID, sub)
+				c.SetRequest(c.Request().WithContext(WithUserID(c.Request().Context(), sub)))
 			}
 			if role, ok := claims["role"].(string); ok {
 				c.Set(CtxRole, role)
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// Transactor runs a unit of work inside a single database transaction. The
+// transaction travels in the context so repositories from different modules
+// can take part in it through Conn.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func NewTransactor(database *Database) Transactor {
+	return database
+}
+
+// WithinTx commits when fn returns nil and rolls back otherwise. Nested calls
+// join the outer transaction.
+func (d *Database) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction bound to ctx, or db scoped to ctx when there is none.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,173 @@
+// Package pdf writes simple documents of text and lines as PDF 1.4. It uses
+// the standard Helvetica fonts every PDF reader provides, so no font is
+// embedded and no external tool is needed.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page size in points (1/72 inch).
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font selects one of the standard fonts.
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+// Document is a PDF under construction. The zero value is ready to use.
+type Document struct {
+	title string
+	pages []*Page
+}
+
+// Page holds the drawing operations of one A4 page. Coordinates are in
+// points from the bottom-left corner, as in PDF itself.
+type Page struct {
+	content bytes.Buffer
+}
+
+// New returns an empty document with the given title in its metadata.
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+// AddPage appends a blank page and returns it.
+func (d *Document) AddPage() *Page {
+	p := &Page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Text draws s with its baseline starting at (x, y).
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s %s Td (%s) Tj ET\n", font+1, num(size), num(x), num(y), escape(encode(s)))
+}
+
+// TextRight draws s with its baseline ending at (x, y).
+func (p *Page) TextRight(x, y float64, font Font, size float64, s string) {
+	p.Text(x-Width(font, size, s), y, font, size, s)
+}
+
+// Line draws a thin line from (x1, y1) to (x2, y2).
+func (p *Page) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "0.5 w %s %s m %s %s l S\n", num(x1), num(y1), num(x2), num(y2))
+}
+
+// Width returns the width of s in points when drawn with font at size.
+func Width(font Font, size float64, s string) float64 {
+	widths := &helvetica
+	if font == Bold {
+		widths = &helveticaBold
+	}
+	var units int
+	for _, c := range encode(s) {
+		if c >= 32 && c <= 126 {
+			units += widths[c-32]
+		} else {
+			units += 556
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+// Bytes renders the document. A document without pages gets one blank page.
+func (d *Document) Bytes() []byte {
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = []*Page{{}}
+	}
+
+	// objects 1-5 are the catalog, the page tree, the two fonts and the
+	// info dictionary; each page then takes a page and a content object
+	var out bytes.Buffer
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (r2-challenge) >>", escape(encode(d.title))))
+	for i, p := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), 7+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.content.Len(), p.content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes()
+}
+
+// encode converts s to WinAnsi bytes; Latin-1 characters are kept and
+// anything else becomes '?'.
+func encode(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r == '\t':
+			b = append(b, ' ')
+		case r >= 32 && r <= 126, r >= 160 && r <= 255:
+			b = append(b, byte(r))
+		default:
+			b = append(b, '?')
+		}
+	}
+	return b
+}
+
+func escape(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		if c == '(' || c == ')' || c == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+func num(f float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
+
+// Glyph widths of the printable ASCII characters, in 1/1000 of the font
+// size, from the Adobe font metrics of the standard fonts.
+var helvetica = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+var helveticaBold = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611, // 0 to ?
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556, // P to _
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611, // ` to o
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584, // p to ~
+}
@@ -0,0 +1,17 @@
+package worker
+
+import "time"
+
+// Backoff returns the delay before retrying after the given number of failed
+// attempts: base, doubled for every attempt after the first and capped at
+// max.
+func Backoff(base time.Duration, attempts int, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
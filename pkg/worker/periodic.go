@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Periodic returns an fx hook that runs fn every interval in the background
+// from app start until app stop. Errors are logged and the loop keeps going.
+func Periodic(name string, interval time.Duration, log *zap.Logger, fn func(ctx context.Context) error) fx.Hook {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	return fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						if err := fn(ctx); err != nil {
+							log.Error("worker run failed", zap.String("worker", name), zap.Error(err))
+						}
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	}
+}